@@ -6,34 +6,65 @@
 // Architecture:
 //   - gRPC on port 50051 for CLI communication (primary interface)
 //   - HTTP on port 8080 for health checks and metrics
+//   - SinglePort mode (see internal/config, internal/httpmux) multiplexes
+//     gRPC, gRPC-Web, and HTTP onto the HTTP address instead
 //   - Structured logging via zap
 //   - Prometheus metrics
 //   - Graceful shutdown on SIGTERM/SIGINT
 //
 // Configuration:
-//   - Environment variables (see internal/config/config.go)
+//   - Config file, environment variables, and CLI flags (see internal/config/config.go)
 //   - Defaults work for local development
+//   - SIGHUP re-reads the config file/environment and hot-swaps the
+//     fields tagged reload:"reloadable" (log level, metrics toggle,
+//     request timeout, concurrency limit, ...) without restarting
 package main
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
+	"github.com/mysgeniels75-byte/ose-api/internal/config"
 	"github.com/mysgeniels75-byte/ose-api/internal/handlers"
+	"github.com/mysgeniels75-byte/ose-api/internal/httpmux"
+	"github.com/mysgeniels75-byte/ose-api/internal/middleware"
+	"github.com/mysgeniels75-byte/ose-api/internal/readiness"
+	"github.com/mysgeniels75-byte/ose-api/internal/tracing"
 	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+	"github.com/mysgeniels75-byte/ose-api/pkg/telemetry"
+	"github.com/mysgeniels75-byte/ose-api/pkg/validation"
+	"github.com/mysgeniels75-byte/ose-api/pkg/validation/webhook"
+	"github.com/mysgeniels75-byte/ose-api/pkg/xi"
 )
 
+// healthProbeInterval is how often the readiness.Registry re-runs its
+// dependency checks and updates the health server's serving status.
+const healthProbeInterval = 10 * time.Second
+
+// advisoryServiceName is the service name AdvisoryService registers under
+// with the health server, distinct from the overall ("") server status
+// grpc_health_probe checks by default.
+const advisoryServiceName = "AdvisoryService"
+
 // Version information (set via ldflags during build)
 var (
 	Version   = "dev"
@@ -42,8 +73,26 @@ var (
 )
 
 func main() {
-	// Initialize structured logger
-	logger, err := initLogger()
+	fs := flag.NewFlagSet("advisory-server", flag.ContinueOnError)
+	cfg, err := config.Load(fs, os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// cfgPtr is the live configuration consulted by request-path tunables
+	// (RequestTimeout, ConcurrencyLimit, the /metrics toggle below); a
+	// SIGHUP swaps it to the freshly reloaded Config so those tunables pick
+	// up the change on the very next RPC, without restarting the process.
+	// Fields tagged reload:"immutable" (GRPCAddress, HTTPAddress, ...) are
+	// only ever read from the startup snapshot in cfg.
+	var cfgPtr atomic.Pointer[config.Config]
+	cfgPtr.Store(cfg)
+
+	// Initialize structured logger. atomicLevel is swapped in place by
+	// watchForReload, so changing log_level takes effect immediately rather
+	// than requiring a new logger (and thus a restart) to pick it up.
+	logger, atomicLevel, err := initLogger(cfg.LogLevel)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
 		os.Exit(1)
@@ -56,68 +105,221 @@ func main() {
 		zap.String("build_time", BuildTime),
 	)
 
-	// Load configuration
-	// Note: Actual config package import will be added when we have the full structure
-	cfg := &Config{
-		GRPCAddress: getEnv("OSE_GRPC_ADDRESS", ":50051"),
-		HTTPAddress: getEnv("OSE_HTTP_ADDRESS", ":8080"),
-		LogLevel:    getEnv("OSE_LOG_LEVEL", "info"),
-	}
-
 	logger.Info("Configuration loaded",
 		zap.String("grpc_address", cfg.GRPCAddress),
 		zap.String("http_address", cfg.HTTPAddress),
+		zap.Bool("single_port", cfg.SinglePort),
 		zap.String("log_level", cfg.LogLevel),
+		zap.Int("max_concurrent_requests", cfg.MaxConcurrentRequests),
+		zap.Duration("request_timeout", cfg.RequestTimeout),
 	)
 
-	// Create gRPC server with middleware
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			loggingInterceptor(logger),
-			metricsInterceptor(),
-			recoveryInterceptor(logger),
-		),
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	go watchForReload(hupChan, &cfgPtr, atomicLevel, logger)
+
+	// configWatchCtx runs cfg.Watch for the lifetime of the process so a
+	// Kubernetes ConfigMap-mounted file update propagates the same way a
+	// SIGHUP does, without requiring the pod to send itself one.
+	configWatchCtx, stopConfigWatch := context.WithCancel(context.Background())
+	defer stopConfigWatch()
+	go func() {
+		if err := watchConfigFile(configWatchCtx, cfg, &cfgPtr, atomicLevel, logger); err != nil && configWatchCtx.Err() == nil {
+			logger.Error("config file watcher stopped", zap.Error(err))
+		}
+	}()
+
+	// Tracing is opt-in (EnableTracing): with it off, no TracerProvider is
+	// registered and middleware.Tracing()'s otelgrpc interceptors fall back
+	// to the global no-op tracer, so the Decorator stays in the pipeline
+	// either way. tracerProvider is flushed during the shutdown sequence
+	// below to avoid dropping spans still sitting in the batcher.
+	var tracerProvider *sdktrace.TracerProvider
+	if cfg.EnableTracing {
+		tp, err := tracing.NewProvider(context.Background(), cfg.TracingEndpoint)
+		if err != nil {
+			logger.Fatal("Failed to initialize tracing", zap.Error(err))
+		}
+		tracerProvider = tp
+		logger.Info("Tracing enabled", zap.String("otlp_endpoint", cfg.TracingEndpoint))
+	}
+
+	// Build the gRPC server's middleware.Pipeline: panic recovery, then
+	// tracing, then request-scoped logging, then Prometheus metrics, then
+	// the reloadable tunables, in that order so recovery always has the
+	// last word over a panicking handler, RequestLogger/Metrics can read
+	// the span Tracing attached to the request context (trace_id/span_id
+	// fields, exemplars), and Metrics observes the status code of every RPC
+	// that returns normally, including ResourceExhausted from the tunables
+	// listed after it; a panic is turned into Internal by Recovery outside
+	// Metrics, so it is not counted by Metrics' per-code counters. Passing AdvisoryService's ServiceDesc to Metrics lets it
+	// pre-initialize every method's metric series at zero, rather than
+	// waiting for each method's first call. RequestTimeout/ConcurrencyLimit
+	// read cfgPtr on every call, so a SIGHUP reload applies to them without
+	// rebuilding the pipeline.
+	grpcServer := middleware.New(
+		middleware.Recovery(logger),
+		middleware.Tracing(),
+		middleware.RequestLogger(logger),
+		middleware.Metrics(prometheus.DefaultRegisterer, pb.AdvisoryService_ServiceDesc),
+		middleware.RequestTimeout(func() time.Duration { return cfgPtr.Load().RequestTimeout }),
+		middleware.ConcurrencyLimit(func() int { return cfgPtr.Load().MaxConcurrentRequests }),
+	).Apply()
+
+	// Telemetry store backs RegisterService's persistence and the pattern
+	// confidence feedback loop. With no DSN configured, fall back to an
+	// in-memory store so local development still works end to end.
+	telemetryStore, err := newTelemetryStore(context.Background(), logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize telemetry store", zap.Error(err))
+	}
+
+	// The CEL rule engine (pkg/validation) starts nil and stays unreachable
+	// by ValidateServiceConstraints* until it's installed here via SetEngine.
+	// AdminService exposes it to the UpdateValidationRules admin RPC, and
+	// advisoryHandler below feeds it accepted requests via Observe so
+	// --dry-run has real samples to evaluate candidate rules against.
+	validationEngine, err := validation.NewEngine(prometheus.DefaultRegisterer)
+	if err != nil {
+		logger.Fatal("Failed to initialize validation rule engine", zap.Error(err))
+	}
+	validation.SetEngine(validationEngine)
+
+	adminService := validation.NewAdminService(validationEngine, 0)
+	pb.RegisterValidationAdminServiceServer(grpcServer, adminService)
+
+	// xiAggregator tracks per-service Ξ components on a sliding EWMA window
+	// (see pkg/xi) and exposes them as Prometheus gauges. advisoryHandler
+	// below feeds it every RegisterService call via ObserveScore, so the
+	// xi_* gauges reflect a rolling window rather than the last registration.
+	// Its eviction loop runs for the lifetime of the process so services
+	// with no recent traffic eventually drop out of the xi_* gauges (their
+	// quality-level counter series are kept).
+	xiAggregator := xi.NewAggregator(
+		xi.WithHalfLife(cfg.XiHalfLife),
+		xi.WithBucketWidth(cfg.XiBucket),
 	)
+	if err := prometheus.DefaultRegisterer.Register(xiAggregator); err != nil {
+		logger.Fatal("Failed to register Ξ aggregator metrics", zap.Error(err))
+	}
+	xiCtx, stopXiEviction := context.WithCancel(context.Background())
+	defer stopXiEviction()
+	go xiAggregator.RunEvictionLoop(xiCtx, healthProbeInterval)
 
 	// Register Advisory Service handler
-	advisoryHandler := handlers.NewAdvisoryHandler(logger)
+	advisoryHandler := handlers.NewAdvisoryHandler(logger, telemetryStore, adminService, xiAggregator)
 	pb.RegisterAdvisoryServiceServer(grpcServer, advisoryHandler)
 
+	// advisoryHandler.blueprintPatterns only shrinks when RegisterService
+	// consumes an entry; this loop bounds the ones that never get
+	// registered at all (see blueprintPatternsTTL).
+	blueprintPatternsCtx, stopBlueprintPatternsEviction := context.WithCancel(context.Background())
+	defer stopBlueprintPatternsEviction()
+	go advisoryHandler.RunBlueprintPatternsEvictionLoop(blueprintPatternsCtx, time.Hour)
+
+	// Register the standard grpc.health.v1.Health service so
+	// grpc_health_probe and Kubernetes gRPC probes can check liveness and
+	// readiness directly against port 50051, rather than only the HTTP
+	// /health and /ready endpoints. readinessRegistry drives both: every
+	// cycle's Snapshot both backs /ready (below) and is mirrored onto
+	// healthServer's serving statuses, so the two probe surfaces can never
+	// disagree.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	readinessRegistry := readiness.NewRegistry(4, 5*time.Second, backendCheckers()...)
+
+	probeCtx, stopProbes := context.WithCancel(context.Background())
+	defer stopProbes()
+	go readinessRegistry.Run(probeCtx, healthProbeInterval, func(snap readiness.Snapshot) {
+		updateHealthStatus(healthServer, snap, logger)
+	})
+
 	// Enable gRPC reflection for debugging (grpcurl, grpc_cli)
 	reflection.Register(grpcServer)
 
-	// Start gRPC server
-	grpcListener, err := net.Listen("tcp", cfg.GRPCAddress)
-	if err != nil {
-		logger.Fatal("Failed to listen on gRPC address", zap.Error(err))
-	}
-
-	grpcErrChan := make(chan error, 1)
-	go func() {
-		logger.Info("Starting gRPC server", zap.String("address", cfg.GRPCAddress))
-		grpcErrChan <- grpcServer.Serve(grpcListener)
-	}()
-
 	// Start HTTP server (health checks + metrics)
 	httpMux := http.NewServeMux()
 	httpMux.HandleFunc("/health", healthCheckHandler)
-	httpMux.HandleFunc("/ready", readinessHandler)
-	httpMux.Handle("/metrics", promhttp.Handler())
+	httpMux.HandleFunc("/ready", readinessHandler(readinessRegistry))
+	httpMux.Handle("/metrics", metricsHandler(&cfgPtr))
+
+	grpcErrChan := make(chan error, 1)
+	var httpHandler http.Handler = httpMux
+
+	if cfg.SinglePort {
+		// SinglePort multiplexes gRPC, gRPC-Web, and the REST handlers above
+		// onto HTTPAddress via h2c; GRPCAddress is not listened on, and
+		// grpcErrChan is never sent to, so the select below only waits on
+		// the HTTP server and the shutdown signal.
+		httpHandler = httpmux.Serve(httpmux.New(grpcServer, httpMux))
+	} else {
+		grpcListener, err := net.Listen("tcp", cfg.GRPCAddress)
+		if err != nil {
+			logger.Fatal("Failed to listen on gRPC address", zap.Error(err))
+		}
+
+		go func() {
+			logger.Info("Starting gRPC server", zap.String("address", cfg.GRPCAddress))
+			grpcErrChan <- grpcServer.Serve(grpcListener)
+		}()
+	}
 
+	// ReadTimeout/WriteTimeout would cut off long-lived gRPC streams, so they
+	// only apply in split-port mode, where HTTPAddress serves plain REST
+	// traffic and the gRPC server has its own listener above.
 	httpServer := &http.Server{
-		Addr:         cfg.HTTPAddress,
-		Handler:      httpMux,
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  60 * time.Second,
+		Addr:        cfg.HTTPAddress,
+		Handler:     httpHandler,
+		IdleTimeout: 60 * time.Second,
+	}
+	if !cfg.SinglePort {
+		httpServer.ReadTimeout = 5 * time.Second
+		httpServer.WriteTimeout = 10 * time.Second
 	}
 
 	httpErrChan := make(chan error, 1)
 	go func() {
-		logger.Info("Starting HTTP server", zap.String("address", cfg.HTTPAddress))
+		if cfg.SinglePort {
+			logger.Info("Starting multiplexed gRPC/gRPC-Web/HTTP server", zap.String("address", cfg.HTTPAddress))
+		} else {
+			logger.Info("Starting HTTP server", zap.String("address", cfg.HTTPAddress))
+		}
 		httpErrChan <- httpServer.ListenAndServe()
 	}()
 
+	// The ValidatingAdmissionWebhook is opt-in: it only starts once both TLS
+	// files are configured, since NewServer can't serve without a
+	// cert/key pair. WebhookAddress always has a default, so the TLS files
+	// are the real toggle (mirrors EnableTracing/EnableMetrics elsewhere in
+	// this function).
+	webhookCtx, stopWebhook := context.WithCancel(context.Background())
+	defer stopWebhook()
+
+	webhookErrChan := make(chan error, 1)
+	if cfg.WebhookCertFile != "" && cfg.WebhookKeyFile != "" {
+		webhookServer, err := webhook.NewServer(cfg.WebhookAddress, cfg.WebhookCertFile, cfg.WebhookKeyFile, webhook.NewHandler(logger), logger)
+		if err != nil {
+			logger.Fatal("Failed to initialize admission webhook", zap.Error(err))
+		}
+		go func() {
+			logger.Info("Starting admission webhook server", zap.String("address", cfg.WebhookAddress))
+			webhookErrChan <- webhookServer.ListenAndServeTLS(webhookCtx)
+		}()
+
+		// Self-registration is opt-in on top of the TLS server: it only
+		// runs once the in-cluster Service identity and CA bundle are also
+		// configured, so a developer running the webhook against a port-
+		// forward (no in-cluster Service) doesn't need to set these.
+		if cfg.WebhookServiceName != "" && cfg.WebhookServiceNamespace != "" && cfg.WebhookCABundleFile != "" {
+			if err := registerWebhook(context.Background(), cfg); err != nil {
+				logger.Fatal("Failed to self-register ValidatingWebhookConfiguration", zap.Error(err))
+			}
+			logger.Info("Registered ValidatingWebhookConfiguration",
+				zap.String("name", webhook.WebhookConfigName))
+		}
+	}
+
 	// Wait for shutdown signal
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
@@ -129,6 +331,8 @@ func main() {
 		if err != http.ErrServerClosed {
 			logger.Fatal("HTTP server failed", zap.Error(err))
 		}
+	case err := <-webhookErrChan:
+		logger.Fatal("admission webhook server failed", zap.Error(err))
 	case sig := <-sigChan:
 		logger.Info("Received shutdown signal", zap.String("signal", sig.String()))
 	}
@@ -139,8 +343,18 @@ func main() {
 	// Stop accepting new gRPC requests (waits for in-flight to complete)
 	grpcServer.GracefulStop()
 
+	// Flush any spans still sitting in the batch span processor before the
+	// process exits.
+	if tracerProvider != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+			logger.Error("tracer provider shutdown error", zap.Error(err))
+		}
+		cancel()
+	}
+
 	// Shutdown HTTP server with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
 	if err := httpServer.Shutdown(ctx); err != nil {
 		logger.Error("HTTP server shutdown error", zap.Error(err))
@@ -149,25 +363,88 @@ func main() {
 	logger.Info("Shutdown complete")
 }
 
-// Config holds server configuration
-type Config struct {
-	GRPCAddress string
-	HTTPAddress string
-	LogLevel    string
+// initLogger creates a production-ready structured logger whose level is
+// held in the returned zap.AtomicLevel rather than baked in at Build time,
+// so watchForReload can raise or lower it on a SIGHUP without rebuilding
+// the logger (which would drop its *zap.Logger reference held by every
+// other goroutine).
+func initLogger(level string) (*zap.Logger, zap.AtomicLevel, error) {
+	atomicLevel := zap.NewAtomicLevel()
+	if err := atomicLevel.UnmarshalText([]byte(level)); err != nil {
+		return nil, atomicLevel, fmt.Errorf("log_level %q: %w", level, err)
+	}
+
+	cfg := zap.NewProductionConfig()
+	cfg.Level = atomicLevel
+	logger, err := cfg.Build()
+	return logger, atomicLevel, err
 }
 
-// initLogger creates a production-ready structured logger
-func initLogger() (*zap.Logger, error) {
-	logLevel := getEnv("OSE_LOG_LEVEL", "info")
+// watchForReload re-reads the config file/environment on every SIGHUP
+// received on hupChan and, if the reload succeeds, swaps level and cfgPtr
+// to the result. A failed reload (bad file, invalid value) is logged and
+// leaves the running configuration untouched rather than crashing the
+// process over a bad edit.
+func watchForReload(hupChan <-chan os.Signal, cfgPtr *atomic.Pointer[config.Config], level zap.AtomicLevel, logger *zap.Logger) {
+	for range hupChan {
+		next, err := cfgPtr.Load().Reload()
+		if err != nil {
+			logger.Error("SIGHUP: configuration reload failed, keeping previous configuration", zap.Error(err))
+			continue
+		}
+		applyConfigReload(next, cfgPtr, level, logger, "SIGHUP")
+	}
+}
 
-	var cfg zap.Config
-	if logLevel == "debug" {
-		cfg = zap.NewDevelopmentConfig()
-	} else {
-		cfg = zap.NewProductionConfig()
+// watchConfigFile runs cfg.Watch for the lifetime of ctx, applying the same
+// reloadable-field swap watchForReload does for SIGHUP whenever the config
+// file changes on disk — the workflow a Kubernetes ConfigMap-mounted file
+// update needs, without a signal to trigger it.
+func watchConfigFile(ctx context.Context, cfg *config.Config, cfgPtr *atomic.Pointer[config.Config], level zap.AtomicLevel, logger *zap.Logger) error {
+	return cfg.Watch(ctx, func(old, next *config.Config, immutableErr error) error {
+		if next == nil {
+			logger.Error("config file reload failed, keeping previous configuration")
+			return nil
+		}
+		if immutableErr != nil {
+			logger.Error("config file change touched an immutable field, ignoring reload (restart required to pick it up)",
+				zap.Error(immutableErr))
+			return nil
+		}
+		applyConfigReload(next, cfgPtr, level, logger, "config file change")
+		return nil
+	})
+}
+
+// applyConfigReload swaps cfgPtr and level to next, the shared final step
+// for both watchForReload (SIGHUP) and watchConfigFile (fsnotify).
+func applyConfigReload(next *config.Config, cfgPtr *atomic.Pointer[config.Config], level zap.AtomicLevel, logger *zap.Logger, via string) {
+	if err := level.UnmarshalText([]byte(next.LogLevel)); err != nil {
+		logger.Error(via+": invalid log_level, leaving log level unchanged", zap.Error(err))
+		next.LogLevel = cfgPtr.Load().LogLevel
 	}
 
-	return cfg.Build()
+	cfgPtr.Store(next)
+	logger.Info("configuration reloaded via "+via,
+		zap.String("log_level", next.LogLevel),
+		zap.Bool("enable_metrics", next.EnableMetrics),
+		zap.Int("max_concurrent_requests", next.MaxConcurrentRequests),
+		zap.Duration("request_timeout", next.RequestTimeout),
+	)
+}
+
+// metricsHandler serves /metrics only while cfgPtr's EnableMetrics is true,
+// so a SIGHUP that flips enable_metrics takes effect on the next scrape
+// without restarting the HTTP server.
+func metricsHandler(cfgPtr *atomic.Pointer[config.Config]) http.Handler {
+	promHandler := promhttp.Handler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !cfgPtr.Load().EnableMetrics {
+			http.NotFound(w, r)
+			return
+		}
+		promHandler.ServeHTTP(w, r)
+	})
 }
 
 // healthCheckHandler returns 200 OK if server is running (liveness probe)
@@ -176,83 +453,109 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte("OK"))
 }
 
-// readinessHandler returns 200 OK if server is ready to accept traffic
-func readinessHandler(w http.ResponseWriter, r *http.Request) {
-	// TODO: Check backend dependencies (Pattern Graph, etc.)
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("READY"))
+// readinessResponse is the JSON body readinessHandler writes: enough for an
+// operator curling /ready to see which dependency is failing without
+// cross-referencing logs.
+type readinessResponse struct {
+	Status  string   `json:"status"`
+	Failing []string `json:"failing,omitempty"`
 }
 
-// getEnv retrieves environment variable with default fallback
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// readinessHandler returns a handler that reports 200 OK only while
+// registry's latest Snapshot is Ready, and otherwise 503 with the names of
+// the failing checks. It reads the cached Snapshot directly rather than
+// running checks inline, so a probe always responds in microseconds
+// regardless of how slow the backends themselves are.
+func readinessHandler(registry *readiness.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snap := registry.Current()
+
+		resp := readinessResponse{Status: "ready"}
+		status := http.StatusOK
+		if !snap.Ready {
+			resp.Status = "not ready"
+			resp.Failing = snap.FailingNames()
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(resp)
 	}
-	return defaultValue
 }
 
-// ═══════════════════════════════════════════════════════════════════════
-// MIDDLEWARE INTERCEPTORS (inline for Week 3)
-// ═══════════════════════════════════════════════════════════════════════
-
-func loggingInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		startTime := time.Now()
-
-		resp, err := handler(ctx, req)
-
-		duration := time.Since(startTime)
+// backendCheckers returns the readiness.Checker set for this service's
+// dependencies. Pattern Graph (Week 5) and the Template Engine (Week 6-8)
+// have no client to check yet, so the list is empty and every Snapshot is
+// trivially Ready; adding a backend is a matter of appending its Checker
+// here, not touching the registration or HTTP/gRPC surfaces above.
+func backendCheckers() []readiness.Checker {
+	// return []readiness.Checker{
+	// 	{Name: "pattern-graph", Check: func(ctx context.Context) error { return patternClient.Ping(ctx) }},
+	// 	{Name: "template-engine", Check: func(ctx context.Context) error { return blueprintGenerator.Ping(ctx) }},
+	// }
+	return nil
+}
 
-		fields := []zap.Field{
-			zap.String("method", info.FullMethod),
-			zap.Duration("duration", duration),
-		}
+// updateHealthStatus mirrors a readiness.Snapshot onto healthServer's
+// overall ("") and per-service statuses, so grpc_health_probe and /ready
+// always agree about whether backend dependencies are up.
+func updateHealthStatus(healthServer *health.Server, snap readiness.Snapshot, logger *zap.Logger) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if !snap.Ready {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+		logger.Warn("readiness check failed, marking service not ready", zap.Strings("failing", snap.FailingNames()))
+	}
 
-		if err != nil {
-			fields = append(fields, zap.Error(err))
-			logger.Error("RPC failed", fields...)
-		} else {
-			logger.Info("RPC succeeded", fields...)
-		}
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(advisoryServiceName, status)
+}
 
-		return resp, err
+// newTelemetryStore constructs the telemetry.Store used by RegisterService.
+// OSE_TELEMETRY_DSN selects Postgres; an unset/empty value falls back to an
+// in-memory store (fine for local development, lost on restart).
+func newTelemetryStore(ctx context.Context, logger *zap.Logger) (telemetry.Store, error) {
+	dsn := getEnv("OSE_TELEMETRY_DSN", "")
+	if dsn == "" {
+		logger.Warn("OSE_TELEMETRY_DSN not set, using in-memory telemetry store")
+		return telemetry.NewMemoryStore(), nil
 	}
+	return telemetry.NewPostgresStore(ctx, dsn)
 }
 
-func metricsInterceptor() grpc.UnaryServerInterceptor {
-	// TODO: Implement Prometheus metrics in Week 3 continuation
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		return handler(ctx, req)
+// getEnv retrieves environment variable with default fallback
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
+	return defaultValue
 }
 
-func recoveryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (resp interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				logger.Error("Panic recovered in RPC handler",
-					zap.String("method", info.FullMethod),
-					zap.Any("panic", r),
-				)
-				err = fmt.Errorf("internal server error: %v", r)
-			}
-		}()
-
-		return handler(ctx, req)
+// registerWebhook builds an in-cluster kubernetes.Interface and calls
+// webhook.Register so the API server starts routing ServiceConstraints
+// writes to this process without a separately-applied manifest.
+func registerWebhook(ctx context.Context, cfg *config.Config) error {
+	restCfg, err := rest.InClusterConfig()
+	if err != nil {
+		return fmt.Errorf("loading in-cluster kubeconfig: %w", err)
 	}
+	client, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return fmt.Errorf("building kubernetes client: %w", err)
+	}
+	caBundle, err := os.ReadFile(cfg.WebhookCABundleFile)
+	if err != nil {
+		return fmt.Errorf("reading webhook CA bundle: %w", err)
+	}
+
+	return webhook.Register(ctx, client, webhook.RegistrationOptions{
+		ServiceName:      cfg.WebhookServiceName,
+		ServiceNamespace: cfg.WebhookServiceNamespace,
+		ServicePort:      cfg.WebhookServicePort,
+		CABundle:         caBundle,
+	})
 }
+
+// Middleware Decorators (panic recovery, request-scoped logging, metrics)
+// live in internal/middleware and are wired in via middleware.New above, so
+// every binary that hosts AdvisoryHandler gets the same protections.
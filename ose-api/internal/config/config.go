@@ -10,46 +10,115 @@
 //   - Local development (defaults work out of the box)
 //   - Container deployment (env vars from K8s ConfigMap)
 //   - Production flexibility (config files for complex settings)
+//
+// Fields are additionally tagged `reload:"reloadable"` or `reload:"immutable"`
+// so that Watch (see watch.go) knows which fields may change underneath a
+// running server and which require a restart.
 package config
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"strconv"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
+// DefaultConfigPath is where the config file is read from when no --config
+// flag is supplied.
+const DefaultConfigPath = "/etc/ose/config.yaml"
+
 // Config holds all configuration for the Advisory Service.
 type Config struct {
 	// Server configuration
-	GRPCAddress string // Address for gRPC server (e.g., ":50051")
-	HTTPAddress string // Address for HTTP server (health checks, metrics)
+	GRPCAddress string `yaml:"grpc_address" reload:"immutable"` // Address for gRPC server (e.g., ":50051")
+	HTTPAddress string `yaml:"http_address" reload:"immutable"` // Address for HTTP server (health checks, metrics)
+	SinglePort  bool   `yaml:"single_port" reload:"immutable"`  // Serve gRPC, gRPC-Web, and HTTP all on HTTPAddress via h2c (see internal/httpmux); GRPCAddress is unused when true
 
 	// Backend services (Week 5+)
-	Neo4jURI      string // Neo4j connection string
-	Neo4jUser     string // Neo4j username
-	Neo4jPassword string // Neo4j password
+	Neo4jURI      string `yaml:"neo4j_uri" reload:"immutable"`
+	Neo4jUser     string `yaml:"neo4j_user" reload:"immutable"`
+	Neo4jPassword string `yaml:"neo4j_password" reload:"immutable"`
 
 	// Template engine (Week 6-8)
-	TemplatePath string // Path to template directory
+	TemplatePath string `yaml:"template_path" reload:"immutable"`
+
+	// Admission webhook (validation/webhook)
+	WebhookAddress  string `yaml:"webhook_address" reload:"immutable"`   // Address the ValidatingAdmissionWebhook listens on (e.g. ":8443")
+	WebhookCertFile string `yaml:"webhook_cert_file" reload:"immutable"` // TLS cert path; rotated in place, not swapped by path
+	WebhookKeyFile  string `yaml:"webhook_key_file" reload:"immutable"`  // TLS key path
+
+	// Webhook self-registration (pkg/validation/webhook.Register): creates
+	// or updates the ValidatingWebhookConfiguration on startup using an
+	// in-cluster kubernetes.Interface, so the API server can find this
+	// webhook without a separately-applied manifest. Opt-in: all three of
+	// WebhookServiceName/Namespace/CABundleFile must be set (mirrors the
+	// cert/key toggle above).
+	WebhookServiceName      string `yaml:"webhook_service_name" reload:"immutable"`
+	WebhookServiceNamespace string `yaml:"webhook_service_namespace" reload:"immutable"`
+	WebhookServicePort      int32  `yaml:"webhook_service_port" reload:"immutable"`
+	WebhookCABundleFile     string `yaml:"webhook_ca_bundle_file" reload:"immutable"`
 
 	// Observability
-	LogLevel      string        // Log level: debug, info, warn, error
-	EnableMetrics bool          // Enable Prometheus metrics endpoint
-	EnableTracing bool          // Enable OpenTelemetry tracing
-	TracingEndpoint string      // OTLP exporter endpoint
+	LogLevel        string `yaml:"log_level" reload:"reloadable"`      // Log level: debug, info, warn, error
+	EnableMetrics   bool   `yaml:"enable_metrics" reload:"reloadable"` // Enable Prometheus metrics endpoint
+	EnableTracing   bool   `yaml:"enable_tracing" reload:"immutable"`  // Enable OpenTelemetry tracing
+	TracingEndpoint string `yaml:"tracing_endpoint" reload:"reloadable"`
 
 	// Performance tuning
-	MaxConcurrentRequests int           // Max concurrent gRPC requests
-	RequestTimeout        time.Duration // Timeout for individual requests
-	ShutdownTimeout       time.Duration // Graceful shutdown timeout
+	MaxConcurrentRequests int           `yaml:"max_concurrent_requests" reload:"reloadable"`
+	RequestTimeout        time.Duration `yaml:"request_timeout" reload:"reloadable"`
+	ShutdownTimeout       time.Duration `yaml:"shutdown_timeout" reload:"immutable"`
+
+	// Ξ aggregation (pkg/xi)
+	XiHalfLife time.Duration `yaml:"xi_halflife" reload:"reloadable"` // EWMA half-life for Ξ components
+	XiBucket   time.Duration `yaml:"xi_bucket" reload:"reloadable"`   // Width of each Range() history bucket
+
+	// ConfigPath records where this Config was loaded from so Watch can
+	// re-read the same file. Not settable via the file itself.
+	ConfigPath string `yaml:"-" reload:"immutable"`
+}
+
+// fileConfig mirrors Config for YAML decoding. Durations and bools are
+// decoded as their YAML-native representations and converted afterward so
+// that a config file can say `request_timeout: 30s` rather than a raw
+// nanosecond integer.
+type fileConfig struct {
+	GRPCAddress             string `yaml:"grpc_address"`
+	HTTPAddress             string `yaml:"http_address"`
+	SinglePort              *bool  `yaml:"single_port"`
+	Neo4jURI                string `yaml:"neo4j_uri"`
+	Neo4jUser               string `yaml:"neo4j_user"`
+	Neo4jPassword           string `yaml:"neo4j_password"`
+	TemplatePath            string `yaml:"template_path"`
+	WebhookAddress          string `yaml:"webhook_address"`
+	WebhookCertFile         string `yaml:"webhook_cert_file"`
+	WebhookKeyFile          string `yaml:"webhook_key_file"`
+	WebhookServiceName      string `yaml:"webhook_service_name"`
+	WebhookServiceNamespace string `yaml:"webhook_service_namespace"`
+	WebhookServicePort      int    `yaml:"webhook_service_port"`
+	WebhookCABundleFile     string `yaml:"webhook_ca_bundle_file"`
+	LogLevel                string `yaml:"log_level"`
+	EnableMetrics           *bool  `yaml:"enable_metrics"`
+	EnableTracing           *bool  `yaml:"enable_tracing"`
+	TracingEndpoint         string `yaml:"tracing_endpoint"`
+	MaxConcurrentRequests   int    `yaml:"max_concurrent_requests"`
+	RequestTimeout          string `yaml:"request_timeout"`
+	ShutdownTimeout         string `yaml:"shutdown_timeout"`
+	XiHalfLife              string `yaml:"xi_halflife"`
+	XiBucket                string `yaml:"xi_bucket"`
 }
 
-// Load reads configuration from environment and returns a Config struct.
+// Load reads configuration from the config file, environment, and CLI flags
+// (in that order of increasing precedence) and returns a validated Config.
 //
 // Environment Variables:
+//   OSE_CONFIG_PATH        - Config file path (default: "/etc/ose/config.yaml")
 //   OSE_GRPC_ADDRESS       - gRPC server address (default: ":50051")
 //   OSE_HTTP_ADDRESS       - HTTP server address (default: ":8080")
+//   OSE_SINGLE_PORT        - Serve gRPC/gRPC-Web/HTTP on HTTPAddress via h2c (default: false)
 //   OSE_NEO4J_URI          - Neo4j connection URI
 //   OSE_NEO4J_USER         - Neo4j username
 //   OSE_NEO4J_PASSWORD     - Neo4j password
@@ -57,40 +126,250 @@ type Config struct {
 //   OSE_LOG_LEVEL          - Logging level (default: "info")
 //   OSE_MAX_CONCURRENT     - Max concurrent requests (default: 100)
 //   OSE_REQUEST_TIMEOUT    - Request timeout in seconds (default: 30)
-func Load() (*Config, error) {
-	cfg := &Config{
-		// Server defaults
-		GRPCAddress: getEnv("OSE_GRPC_ADDRESS", ":50051"),
-		HTTPAddress: getEnv("OSE_HTTP_ADDRESS", ":8080"),
-
-		// Backend defaults (will be required in Week 5)
-		Neo4jURI:      getEnv("OSE_NEO4J_URI", "bolt://localhost:7687"),
-		Neo4jUser:     getEnv("OSE_NEO4J_USER", "neo4j"),
-		Neo4jPassword: getEnv("OSE_NEO4J_PASSWORD", ""),
-
-		// Template defaults
-		TemplatePath: getEnv("OSE_TEMPLATE_PATH", "./templates"),
-
-		// Observability defaults
-		LogLevel:        getEnv("OSE_LOG_LEVEL", "info"),
-		EnableMetrics:   getEnvBool("OSE_ENABLE_METRICS", true),
-		EnableTracing:   getEnvBool("OSE_ENABLE_TRACING", false),
-		TracingEndpoint: getEnv("OSE_TRACING_ENDPOINT", "localhost:4317"),
-
-		// Performance defaults
-		MaxConcurrentRequests: getEnvInt("OSE_MAX_CONCURRENT", 100),
-		RequestTimeout:        time.Duration(getEnvInt("OSE_REQUEST_TIMEOUT", 30)) * time.Second,
-		ShutdownTimeout:       30 * time.Second,
+//
+// CLI Flags (registered on the supplied flag.FlagSet, parsed against args):
+//   --config            - Config file path, overrides OSE_CONFIG_PATH
+//   --grpc-address       - Overrides OSE_GRPC_ADDRESS
+//   --http-address       - Overrides OSE_HTTP_ADDRESS
+//   --log-level          - Overrides OSE_LOG_LEVEL
+//   --single-port        - Overrides OSE_SINGLE_PORT
+//
+// Load calls Validate() after each layer is applied so a bad file, a bad
+// env var, or a bad flag each fail fast with a layer-specific error.
+func Load(fs *flag.FlagSet, args []string) (*Config, error) {
+	cfg := defaults()
+
+	configPath := getEnv("OSE_CONFIG_PATH", DefaultConfigPath)
+
+	if err := applyFile(cfg, configPath); err != nil {
+		return nil, fmt.Errorf("config: file layer: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("config: file layer: %w", err)
+	}
+
+	applyEnv(cfg)
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("config: env layer: %w", err)
+	}
+
+	flagConfigPath := configPath
+	fs.StringVar(&flagConfigPath, "config", configPath, "path to YAML config file")
+	fs.StringVar(&cfg.GRPCAddress, "grpc-address", cfg.GRPCAddress, "gRPC server address")
+	fs.StringVar(&cfg.HTTPAddress, "http-address", cfg.HTTPAddress, "HTTP server address")
+	fs.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "log level (debug, info, warn, error)")
+	fs.BoolVar(&cfg.SinglePort, "single-port", cfg.SinglePort, "serve gRPC, gRPC-Web, and HTTP on http-address via h2c")
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("config: flag layer: %w", err)
+	}
+
+	// If --config pointed somewhere new, re-run the file layer underneath
+	// whatever env/flags already set, since flags must still win.
+	if flagConfigPath != configPath {
+		reloaded := defaults()
+		if err := applyFile(reloaded, flagConfigPath); err != nil {
+			return nil, fmt.Errorf("config: flag layer: %w", err)
+		}
+		applyEnv(reloaded)
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "grpc-address":
+				reloaded.GRPCAddress = cfg.GRPCAddress
+			case "http-address":
+				reloaded.HTTPAddress = cfg.HTTPAddress
+			case "log-level":
+				reloaded.LogLevel = cfg.LogLevel
+			case "single-port":
+				reloaded.SinglePort = cfg.SinglePort
+			}
+		})
+		cfg = reloaded
+		configPath = flagConfigPath
 	}
+	cfg.ConfigPath = configPath
 
-	// Validate required fields
 	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("configuration validation failed: %w", err)
+		return nil, fmt.Errorf("config: flag layer: %w", err)
 	}
 
 	return cfg, nil
 }
 
+// defaults returns a Config populated with the lowest-precedence defaults.
+func defaults() *Config {
+	return &Config{
+		GRPCAddress: ":50051",
+		HTTPAddress: ":8080",
+		SinglePort:  false,
+
+		Neo4jURI:      "bolt://localhost:7687",
+		Neo4jUser:     "neo4j",
+		Neo4jPassword: "",
+
+		TemplatePath: "./templates",
+
+		WebhookAddress:  ":8443",
+		WebhookCertFile: "",
+		WebhookKeyFile:  "",
+
+		WebhookServiceName:      "",
+		WebhookServiceNamespace: "",
+		WebhookServicePort:      443,
+		WebhookCABundleFile:     "",
+
+		LogLevel:        "info",
+		EnableMetrics:   true,
+		EnableTracing:   false,
+		TracingEndpoint: "localhost:4317",
+
+		MaxConcurrentRequests: 100,
+		RequestTimeout:        30 * time.Second,
+		ShutdownTimeout:       30 * time.Second,
+
+		XiHalfLife: 7 * 24 * time.Hour,
+		XiBucket:   time.Hour,
+	}
+}
+
+// applyFile overlays a YAML config file onto cfg. A missing file is not an
+// error — it simply means this layer contributes nothing, which is the
+// common case for local development.
+func applyFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var fc fileConfig
+	if err := yaml.Unmarshal(data, &fc); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	if fc.GRPCAddress != "" {
+		cfg.GRPCAddress = fc.GRPCAddress
+	}
+	if fc.HTTPAddress != "" {
+		cfg.HTTPAddress = fc.HTTPAddress
+	}
+	if fc.SinglePort != nil {
+		cfg.SinglePort = *fc.SinglePort
+	}
+	if fc.Neo4jURI != "" {
+		cfg.Neo4jURI = fc.Neo4jURI
+	}
+	if fc.Neo4jUser != "" {
+		cfg.Neo4jUser = fc.Neo4jUser
+	}
+	if fc.Neo4jPassword != "" {
+		cfg.Neo4jPassword = fc.Neo4jPassword
+	}
+	if fc.TemplatePath != "" {
+		cfg.TemplatePath = fc.TemplatePath
+	}
+	if fc.WebhookAddress != "" {
+		cfg.WebhookAddress = fc.WebhookAddress
+	}
+	if fc.WebhookCertFile != "" {
+		cfg.WebhookCertFile = fc.WebhookCertFile
+	}
+	if fc.WebhookKeyFile != "" {
+		cfg.WebhookKeyFile = fc.WebhookKeyFile
+	}
+	if fc.WebhookServiceName != "" {
+		cfg.WebhookServiceName = fc.WebhookServiceName
+	}
+	if fc.WebhookServiceNamespace != "" {
+		cfg.WebhookServiceNamespace = fc.WebhookServiceNamespace
+	}
+	if fc.WebhookServicePort != 0 {
+		cfg.WebhookServicePort = int32(fc.WebhookServicePort)
+	}
+	if fc.WebhookCABundleFile != "" {
+		cfg.WebhookCABundleFile = fc.WebhookCABundleFile
+	}
+	if fc.LogLevel != "" {
+		cfg.LogLevel = fc.LogLevel
+	}
+	if fc.EnableMetrics != nil {
+		cfg.EnableMetrics = *fc.EnableMetrics
+	}
+	if fc.EnableTracing != nil {
+		cfg.EnableTracing = *fc.EnableTracing
+	}
+	if fc.TracingEndpoint != "" {
+		cfg.TracingEndpoint = fc.TracingEndpoint
+	}
+	if fc.MaxConcurrentRequests != 0 {
+		cfg.MaxConcurrentRequests = fc.MaxConcurrentRequests
+	}
+	if fc.RequestTimeout != "" {
+		d, err := time.ParseDuration(fc.RequestTimeout)
+		if err != nil {
+			return fmt.Errorf("request_timeout: %w", err)
+		}
+		cfg.RequestTimeout = d
+	}
+	if fc.ShutdownTimeout != "" {
+		d, err := time.ParseDuration(fc.ShutdownTimeout)
+		if err != nil {
+			return fmt.Errorf("shutdown_timeout: %w", err)
+		}
+		cfg.ShutdownTimeout = d
+	}
+	if fc.XiHalfLife != "" {
+		d, err := time.ParseDuration(fc.XiHalfLife)
+		if err != nil {
+			return fmt.Errorf("xi_halflife: %w", err)
+		}
+		cfg.XiHalfLife = d
+	}
+	if fc.XiBucket != "" {
+		d, err := time.ParseDuration(fc.XiBucket)
+		if err != nil {
+			return fmt.Errorf("xi_bucket: %w", err)
+		}
+		cfg.XiBucket = d
+	}
+
+	return nil
+}
+
+// applyEnv overlays OSE_* environment variables onto cfg.
+func applyEnv(cfg *Config) {
+	cfg.GRPCAddress = getEnv("OSE_GRPC_ADDRESS", cfg.GRPCAddress)
+	cfg.HTTPAddress = getEnv("OSE_HTTP_ADDRESS", cfg.HTTPAddress)
+	cfg.SinglePort = getEnvBool("OSE_SINGLE_PORT", cfg.SinglePort)
+
+	cfg.Neo4jURI = getEnv("OSE_NEO4J_URI", cfg.Neo4jURI)
+	cfg.Neo4jUser = getEnv("OSE_NEO4J_USER", cfg.Neo4jUser)
+	cfg.Neo4jPassword = getEnv("OSE_NEO4J_PASSWORD", cfg.Neo4jPassword)
+
+	cfg.TemplatePath = getEnv("OSE_TEMPLATE_PATH", cfg.TemplatePath)
+
+	cfg.WebhookAddress = getEnv("OSE_WEBHOOK_ADDRESS", cfg.WebhookAddress)
+	cfg.WebhookCertFile = getEnv("OSE_WEBHOOK_CERT_FILE", cfg.WebhookCertFile)
+	cfg.WebhookKeyFile = getEnv("OSE_WEBHOOK_KEY_FILE", cfg.WebhookKeyFile)
+
+	cfg.WebhookServiceName = getEnv("OSE_WEBHOOK_SERVICE_NAME", cfg.WebhookServiceName)
+	cfg.WebhookServiceNamespace = getEnv("OSE_WEBHOOK_SERVICE_NAMESPACE", cfg.WebhookServiceNamespace)
+	cfg.WebhookServicePort = int32(getEnvInt("OSE_WEBHOOK_SERVICE_PORT", int(cfg.WebhookServicePort)))
+	cfg.WebhookCABundleFile = getEnv("OSE_WEBHOOK_CA_BUNDLE_FILE", cfg.WebhookCABundleFile)
+
+	cfg.LogLevel = getEnv("OSE_LOG_LEVEL", cfg.LogLevel)
+	cfg.EnableMetrics = getEnvBool("OSE_ENABLE_METRICS", cfg.EnableMetrics)
+	cfg.EnableTracing = getEnvBool("OSE_ENABLE_TRACING", cfg.EnableTracing)
+	cfg.TracingEndpoint = getEnv("OSE_TRACING_ENDPOINT", cfg.TracingEndpoint)
+
+	cfg.MaxConcurrentRequests = getEnvInt("OSE_MAX_CONCURRENT", cfg.MaxConcurrentRequests)
+	cfg.RequestTimeout = time.Duration(getEnvInt("OSE_REQUEST_TIMEOUT", int(cfg.RequestTimeout/time.Second))) * time.Second
+
+	cfg.XiHalfLife = getEnvDuration("OSE_XI_HALFLIFE", cfg.XiHalfLife)
+	cfg.XiBucket = getEnvDuration("OSE_XI_BUCKET", cfg.XiBucket)
+}
+
 // Validate checks that all required configuration is present and valid.
 func (c *Config) Validate() error {
 	// In Week 3, most validations are lenient (backends not yet required)
@@ -112,6 +391,31 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("request timeout must be positive, got %v", c.RequestTimeout)
 	}
 
+	if c.XiHalfLife <= 0 {
+		return fmt.Errorf("xi half-life must be positive, got %v", c.XiHalfLife)
+	}
+
+	if c.XiBucket <= 0 {
+		return fmt.Errorf("xi bucket width must be positive, got %v", c.XiBucket)
+	}
+
+	// Webhook self-registration is all-or-nothing: a partially configured
+	// set would otherwise fail confusingly deep inside registerWebhook at
+	// startup instead of at config validation time.
+	webhookSelfRegisterFieldsSet := 0
+	if c.WebhookServiceName != "" {
+		webhookSelfRegisterFieldsSet++
+	}
+	if c.WebhookServiceNamespace != "" {
+		webhookSelfRegisterFieldsSet++
+	}
+	if c.WebhookCABundleFile != "" {
+		webhookSelfRegisterFieldsSet++
+	}
+	if webhookSelfRegisterFieldsSet != 0 && webhookSelfRegisterFieldsSet != 3 {
+		return fmt.Errorf("webhook self-registration requires webhook_service_name, webhook_service_namespace, and webhook_ca_bundle_file all set together")
+	}
+
 	// Validate log level
 	validLogLevels := map[string]bool{
 		"debug": true, "info": true, "warn": true, "error": true,
@@ -141,6 +445,17 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvDuration retrieves an environment variable as a time.Duration with
+// default fallback, accepting Go duration syntax (e.g. "168h", "30s").
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
 // getEnvBool retrieves an environment variable as boolean with default fallback.
 func getEnvBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDebounce is how long Watch waits after the last filesystem event
+// before re-reading the config file. Editors that save atomically (write a
+// temp file, then rename over the original) emit several events per save;
+// debouncing avoids reloading once per event.
+const watchDebounce = 500 * time.Millisecond
+
+// ErrImmutableFieldChanged is what Watch passes to its onChange callback (as
+// immutableErr) when a config file edit changes a field tagged
+// `reload:"immutable"` (e.g. GRPCAddress, Neo4jURI). The callback decides
+// whether to log-and-ignore the change or restart the process to pick it up.
+type ErrImmutableFieldChanged struct {
+	Field string
+	Old   string
+	New   string
+}
+
+func (e *ErrImmutableFieldChanged) Error() string {
+	return fmt.Sprintf("config: immutable field %q changed (%q -> %q); restart required", e.Field, e.Old, e.New)
+}
+
+// Watch observes the config file backing cfg for writes, renames, and
+// removes (the pattern used by ConfigMap-mounted files and atomic-save
+// editors, including the symlink-swap Kubernetes uses to rotate a mounted
+// ConfigMap/Secret) and invokes onChange with the previous and
+// newly-reloaded Config whenever it changes. Events are debounced by
+// watchDebounce to collapse the burst of events a single save produces.
+//
+// Only fields tagged `reload:"reloadable"` are expected to differ between
+// old and new; if a `reload:"immutable"` field differs, immutableErr is a
+// non-nil *ErrImmutableFieldChanged so the caller can decide to ignore the
+// change or restart to pick it up. Watch itself never mutates c.
+//
+// Watch blocks until ctx is cancelled or the underlying watcher fails to
+// initialize.
+func (c *Config) Watch(ctx context.Context, onChange func(old, new *Config, immutableErr error) error) error {
+	if c.ConfigPath == "" {
+		return fmt.Errorf("config: Watch requires a ConfigPath (load via Load first)")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("config: creating watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(c.ConfigPath); err != nil {
+		return fmt.Errorf("config: watching %s: %w", c.ConfigPath, err)
+	}
+
+	current := c
+	var debounce *time.Timer
+	reload := make(chan struct{}, 1)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if debounce != nil {
+				debounce.Stop()
+			}
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return fmt.Errorf("config: watcher closed unexpectedly")
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Rename|fsnotify.Create|fsnotify.Remove) == 0 {
+				continue
+			}
+			// A rename or remove (atomic save/symlink-swap replacing the
+			// inode, e.g. how Kubernetes rotates a mounted ConfigMap/Secret)
+			// means we must re-add the watch, since fsnotify watches
+			// inodes, not paths; the new inode may not exist yet the
+			// instant the event fires, so a failed Add here isn't fatal —
+			// it's retried on the next event.
+			if event.Op&(fsnotify.Rename|fsnotify.Remove) != 0 {
+				_ = watcher.Add(c.ConfigPath)
+			}
+			if debounce == nil {
+				debounce = time.AfterFunc(watchDebounce, func() {
+					select {
+					case reload <- struct{}{}:
+					default:
+					}
+				})
+			} else {
+				debounce.Reset(watchDebounce)
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return fmt.Errorf("config: watcher closed unexpectedly")
+			}
+			return fmt.Errorf("config: watch error: %w", err)
+
+		case <-reload:
+			next, err := reloadLayered(current)
+			if err != nil {
+				// A bad edit shouldn't take down the watcher; surface it
+				// and keep watching for the next (hopefully fixed) save.
+				if err := onChange(current, nil, nil); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := onChange(current, next, diffImmutable(current, next)); err != nil {
+				return err
+			}
+
+			current = next
+		}
+	}
+}
+
+// Reload re-runs the file+env layers against c.ConfigPath and returns the
+// resulting Config, without starting an fsnotify watch. A SIGHUP handler
+// typically calls this directly rather than using Watch, since a signal
+// already tells it exactly when to reload.
+func (c *Config) Reload() (*Config, error) {
+	return reloadLayered(c)
+}
+
+// reloadLayered re-runs the file+env layers (CLI flags are fixed for the
+// process lifetime and are not re-parsed on reload) against the same
+// ConfigPath, starting from defaults so removed keys fall back correctly.
+func reloadLayered(prev *Config) (*Config, error) {
+	next := defaults()
+	if err := applyFile(next, prev.ConfigPath); err != nil {
+		return nil, err
+	}
+	applyEnv(next)
+	next.ConfigPath = prev.ConfigPath
+
+	if err := next.Validate(); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// diffImmutable returns a non-nil *ErrImmutableFieldChanged describing the
+// first immutable field that differs between old and new, or nil if none do.
+func diffImmutable(old, new *Config) error {
+	ov := reflect.ValueOf(*old)
+	nv := reflect.ValueOf(*new)
+	t := ov.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get("reload") != "immutable" {
+			continue
+		}
+
+		oldVal := fmt.Sprintf("%v", ov.Field(i).Interface())
+		newVal := fmt.Sprintf("%v", nv.Field(i).Interface())
+		if oldVal != newVal {
+			return &ErrImmutableFieldChanged{
+				Field: field.Name,
+				Old:   oldVal,
+				New:   newVal,
+			}
+		}
+	}
+
+	return nil
+}
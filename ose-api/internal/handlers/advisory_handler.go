@@ -7,6 +7,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -14,9 +15,31 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+	"github.com/mysgeniels75-byte/ose-api/pkg/telemetry"
 	"github.com/mysgeniels75-byte/ose-api/pkg/validation"
+	"github.com/mysgeniels75-byte/ose-api/pkg/xi"
 )
 
+// invalidConstraintsStatus builds the InvalidArgument status returned for a
+// failed ValidateServiceConstraints call. When err is a *ValidationReport it
+// attaches a google.rpc.BadRequest detail (field_violations) so
+// grpc-gateway/grpc-web clients can render per-field errors with their
+// standard tooling instead of parsing the message string.
+func invalidConstraintsStatus(ctx context.Context, err error) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid service constraints: %v", err))
+
+	report, ok := err.(*validation.ValidationReport)
+	if !ok {
+		return st.Err()
+	}
+
+	withDetails, detailErr := st.WithDetails(report.AsProto(ctx))
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
 // AdvisoryHandler implements the AdvisoryService gRPC service.
 //
 // This handler is the orchestration layer that:
@@ -31,16 +54,61 @@ import (
 // Week 6-8: Replace mocks with real Blueprint generation
 type AdvisoryHandler struct {
 	pb.UnimplementedAdvisoryServiceServer
-	logger *zap.Logger
+	logger   *zap.Logger
+	producer BlueprintProducer
+	// defaultPolicy is the server-wide enforcement policy applied whenever a
+	// request doesn't supply its own (see resolvePolicy). The zero value
+	// falls back to each rule's own Severity, preserving prior behavior.
+	defaultPolicy validation.Policy
+
+	// store persists every RegisterService call (Week 9-10's telemetry
+	// feedback loop); confidenceUpdater derives per-pattern Beta posteriors
+	// from it. Both are nil-safe: with no store configured, RegisterService
+	// still computes and returns the Ξ score, it just doesn't persist it.
+	store             telemetry.Store
+	confidenceUpdater *telemetry.PatternConfidenceUpdater
+
+	// admin is nil-safe: with no AdminService configured, requests validate
+	// the same way but --dry-run has no recent samples to evaluate against
+	// (see AdminService.Observe).
+	admin *validation.AdminService
+
+	// xiAggregator is nil-safe: with none configured, RegisterService still
+	// computes and returns the Ξ score, it just isn't folded into the
+	// per-service EWMA the ose_xi_* Prometheus gauges publish.
+	xiAggregator *xi.Aggregator
+
+	// blueprintPatterns remembers the recommended pattern IDs for each
+	// blueprint_id handed out by GenerateBlueprint/GenerateBlueprintStream,
+	// so RegisterService can compute Relevance without requiring the caller
+	// to echo back what was recommended. Entries are deleted once
+	// RegisterService consumes them; RunBlueprintPatternsEvictionLoop bounds
+	// the ones that never get registered at all.
+	blueprintPatterns sync.Map // blueprint_id string -> blueprintPatternsEntry
+
 	// patternClient      *patterns.Client      // Week 5: Neo4j Pattern Graph
 	// blueprintGenerator *generator.Generator  // Week 6-8: Template Engine
 }
 
-// NewAdvisoryHandler creates a new advisory service handler.
-func NewAdvisoryHandler(logger *zap.Logger) *AdvisoryHandler {
-	return &AdvisoryHandler{
-		logger: logger,
+// NewAdvisoryHandler creates a new advisory service handler. store may be
+// nil, in which case registration telemetry and pattern confidence are
+// disabled (see the store field). admin may also be nil, in which case
+// --dry-run rule evaluation has no recently-accepted constraints to draw on
+// (see the admin field). xiAggregator may also be nil, in which case
+// RegisterService computes the Ξ score but doesn't feed it into the
+// production EWMA tracker (see the xiAggregator field).
+func NewAdvisoryHandler(logger *zap.Logger, store telemetry.Store, admin *validation.AdminService, xiAggregator *xi.Aggregator) *AdvisoryHandler {
+	h := &AdvisoryHandler{
+		logger:       logger,
+		store:        store,
+		admin:        admin,
+		xiAggregator: xiAggregator,
 	}
+	h.producer = newMockBlueprintProducer(h)
+	if store != nil {
+		h.confidenceUpdater = telemetry.NewPatternConfidenceUpdater(store)
+	}
+	return h
 }
 
 // ═════════════════════════════════════════════════════════════════════════════
@@ -49,15 +117,9 @@ func NewAdvisoryHandler(logger *zap.Logger) *AdvisoryHandler {
 
 // GenerateBlueprint generates an architectural blueprint based on service constraints.
 //
-// This is the primary advisory interface. It takes service requirements and returns
-// a complete blueprint with recommended patterns, generated artifacts, and quality targets.
-//
-// Request Flow:
-//   1. Validate service constraints (pkg/validation)
-//   2. Query Pattern Graph for relevant patterns (Week 5)
-//   3. Generate artifacts from templates (Week 6-8)
-//   4. Calculate performance targets (Week 9-10)
-//   5. Return complete blueprint
+// This is a thin wrapper around GenerateBlueprintStream that drains the
+// stage events into a single response, kept for clients that don't need
+// progressive delivery.
 //
 // Week 3 Implementation: Returns mock blueprint after validation
 func (h *AdvisoryHandler) GenerateBlueprint(
@@ -68,52 +130,180 @@ func (h *AdvisoryHandler) GenerateBlueprint(
 		zap.String("service_name", req.GetConstraints().GetServiceName()),
 	)
 
-	// ═════════════════════════════════════════════════════════════════════════
-	// STEP 1: VALIDATION AT THE BOUNDARY
-	// ═════════════════════════════════════════════════════════════════════════
+	policy := resolvePolicy(h.defaultPolicy, req.GetPolicy())
 
-	if err := validation.ValidateServiceConstraints(req.GetConstraints()); err != nil {
+	// ValidateServiceConstraintsWithPolicy consults policy for its own
+	// CEL rule pass, so a rule scoped WARN/DRYRUN here never hard-fails the
+	// request even when its Severity is ERROR (see the Policy doc in
+	// pkg/validation/policy.go).
+	if err := validation.ValidateServiceConstraintsWithPolicy(req.GetConstraints(), policy); err != nil {
 		h.logger.Warn("Invalid service constraints",
 			zap.Error(err),
 			zap.String("service_name", req.GetConstraints().GetServiceName()),
 		)
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid service constraints: %v", err)
+		return nil, invalidConstraintsStatus(ctx, err)
+	}
+	h.observe(req.GetConstraints())
+
+	var warnings []string
+	if engine := validation.DefaultEngine(); engine != nil {
+		for _, outcome := range engine.EvaluateWithPolicy(req.GetConstraints(), policy) {
+			if outcome.Passed || outcome.Scope == validation.ScopeDeny {
+				continue
+			}
+			switch outcome.Scope {
+			case validation.ScopeWarn:
+				warnings = append(warnings, outcome.Message)
+			case validation.ScopeDryRun:
+				h.logger.Info("dry-run rule failed",
+					zap.String("rule_id", outcome.RuleID),
+					zap.String("message", outcome.Message),
+				)
+			}
+		}
 	}
 
-	// ═════════════════════════════════════════════════════════════════════════
-	// STEP 2: MOCK RESPONSE (Week 3 infrastructure testing)
-	// ═════════════════════════════════════════════════════════════════════════
+	events, errs := h.producer.Produce(ctx, req.GetConstraints())
+	blueprint, err := drainProducer(events, errs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generating blueprint: %v", err)
+	}
+	blueprint.ServiceName = req.GetConstraints().GetServiceName()
+	blueprint.Warnings = warnings
+	h.recordBlueprintPatterns(blueprint.GetBlueprintId(), blueprint.GetPatterns())
 
-	blueprintID := generateBlueprintID(req.GetConstraints().GetServiceName())
+	h.logger.Info("Blueprint generated",
+		zap.String("blueprint_id", blueprint.GetBlueprintId()),
+		zap.Int("pattern_count", len(blueprint.GetPatterns())),
+		zap.Int("artifact_count", len(blueprint.GetArtifacts())),
+	)
 
-	// Mock recommended patterns based on service type
-	patterns := h.mockRecommendedPatterns(req.GetConstraints())
+	return &pb.GenerateBlueprintResponse{
+		Blueprint: blueprint,
+	}, nil
+}
 
-	// Mock generated artifacts
-	artifacts := h.mockGeneratedArtifacts(req.GetConstraints())
+// GenerateBlueprintStream is the server-streaming counterpart of
+// GenerateBlueprint: it validates once, then forwards each BlueprintProducer
+// stage to the client as soon as it's ready — useful once Week 6-8's real
+// artifact generation is slow enough that clients benefit from progress
+// rather than waiting for the full blueprint.
+func (h *AdvisoryHandler) GenerateBlueprintStream(
+	req *pb.GenerateBlueprintRequest,
+	stream pb.AdvisoryService_GenerateBlueprintStreamServer,
+) error {
+	ctx := stream.Context()
 
-	// Mock performance targets
-	targets := h.mockPerformanceTargets(req.GetConstraints())
+	h.logger.Info("GenerateBlueprintStream called",
+		zap.String("service_name", req.GetConstraints().GetServiceName()),
+	)
+
+	policy := resolvePolicy(h.defaultPolicy, req.GetPolicy())
 
-	blueprint := &pb.Blueprint{
-		BlueprintId:         blueprintID,
-		ServiceName:         req.GetConstraints().GetServiceName(),
-		Patterns:            patterns,
-		Artifacts:           artifacts,
-		PerformanceTargets:  targets,
-		GeneratedAt:         time.Now().Unix(),
+	if err := validation.ValidateServiceConstraintsWithPolicy(req.GetConstraints(), policy); err != nil {
+		h.logger.Warn("Invalid service constraints",
+			zap.Error(err),
+			zap.String("service_name", req.GetConstraints().GetServiceName()),
+		)
+		return invalidConstraintsStatus(ctx, err)
+	}
+	h.observe(req.GetConstraints())
+
+	if engine := validation.DefaultEngine(); engine != nil {
+		for _, outcome := range engine.EvaluateWithPolicy(req.GetConstraints(), policy) {
+			if outcome.Passed || outcome.Scope == validation.ScopeDeny {
+				continue
+			}
+			// The streaming response has no event carrying warnings (unlike
+			// Blueprint.Warnings on the unary path), so WARN/DRYRUN rules are
+			// logged only here.
+			h.logger.Info("non-denying rule failed",
+				zap.String("rule_id", outcome.RuleID),
+				zap.String("scope", string(outcome.Scope)),
+				zap.String("message", outcome.Message),
+			)
+		}
 	}
 
-	h.logger.Info("Blueprint generated",
-		zap.String("blueprint_id", blueprintID),
-		zap.Int("pattern_count", len(patterns)),
-		zap.Int("artifact_count", len(artifacts)),
+	var (
+		blueprintID string
+		patterns    []*pb.RecommendedPattern
 	)
 
-	return &pb.GenerateBlueprintResponse{
-		Blueprint: blueprint,
-	}, nil
+	events, errs := h.producer.Produce(ctx, req.GetConstraints())
+	for ev := range events {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		default:
+		}
+		switch e := ev.GetEvent().(type) {
+		case *pb.BlueprintEvent_PatternRecommended:
+			patterns = append(patterns, e.PatternRecommended)
+		case *pb.BlueprintEvent_BlueprintFinalized:
+			blueprintID = e.BlueprintFinalized.GetBlueprintId()
+		}
+		if err := stream.Send(ev); err != nil {
+			return status.Errorf(codes.Unavailable, "sending blueprint event: %v", err)
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return status.Errorf(codes.Internal, "generating blueprint: %v", err)
+	}
+
+	h.recordBlueprintPatterns(blueprintID, patterns)
+	return nil
+}
+
+// blueprintPatternsTTL bounds how long a blueprint's recommended patterns
+// are remembered when the caller never follows up with RegisterService;
+// RunBlueprintPatternsEvictionLoop sweeps anything older than this.
+const blueprintPatternsTTL = 24 * time.Hour
+
+// blueprintPatternsEntry is the value stored in AdvisoryHandler.blueprintPatterns.
+type blueprintPatternsEntry struct {
+	patternIDs []string
+	recordedAt time.Time
+}
+
+// recordBlueprintPatterns remembers the recommended pattern IDs for
+// blueprintID so a later RegisterService call can source Relevance's
+// patterns_recommended/avg_confidence without the caller having to echo
+// them back.
+func (h *AdvisoryHandler) recordBlueprintPatterns(blueprintID string, patterns []*pb.RecommendedPattern) {
+	if blueprintID == "" {
+		return
+	}
+	ids := make([]string, len(patterns))
+	for i, p := range patterns {
+		ids[i] = p.GetPatternId()
+	}
+	h.blueprintPatterns.Store(blueprintID, blueprintPatternsEntry{patternIDs: ids, recordedAt: time.Now()})
+}
+
+// RunBlueprintPatternsEvictionLoop runs in the background, removing
+// blueprintPatterns entries older than blueprintPatternsTTL, until ctx is
+// cancelled. Bounds memory for blueprints that are generated but never
+// registered. The caller is expected to run this as a goroutine.
+func (h *AdvisoryHandler) RunBlueprintPatternsEvictionLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			h.blueprintPatterns.Range(func(key, value any) bool {
+				entry, ok := value.(blueprintPatternsEntry)
+				if ok && now.Sub(entry.recordedAt) > blueprintPatternsTTL {
+					h.blueprintPatterns.Delete(key)
+				}
+				return true
+			})
+		}
+	}
 }
 
 // ═════════════════════════════════════════════════════════════════════════════
@@ -174,20 +364,106 @@ func (h *AdvisoryHandler) ValidateService(
 		zap.String("service_name", req.GetConstraints().GetServiceName()),
 	)
 
-	err := validation.ValidateServiceConstraints(req.GetConstraints())
+	report := &pb.ValidationReport{}
+	valid := true
+
+	// ValidateServiceConstraintsStructural deliberately skips the CEL rule
+	// engine so the EvaluateWithPolicy loop below is the single source of
+	// truth for rule-based outcomes — ValidateServiceConstraints would
+	// double-evaluate the same rules at default (non-policy) scope.
+	if err := validation.ValidateServiceConstraintsStructural(req.GetConstraints()); err != nil {
+		valid = false
+		for _, ve := range structuralErrors(err) {
+			report.Outcomes = append(report.Outcomes, &pb.RuleOutcome{
+				RuleId:    ve.Code,
+				Scope:     string(validation.ScopeDeny),
+				Passed:    false,
+				Message:   ve.Error(),
+				FieldPath: ve.Field,
+			})
+		}
+	}
 
-	if err != nil {
-		return &pb.ValidateServiceResponse{
-			Valid:        false,
-			ErrorMessage: err.Error(),
-		}, nil
+	if engine := validation.DefaultEngine(); engine != nil {
+		policy := resolvePolicy(h.defaultPolicy, req.GetPolicy())
+		for _, outcome := range engine.EvaluateWithPolicy(req.GetConstraints(), policy) {
+			report.Outcomes = append(report.Outcomes, &pb.RuleOutcome{
+				RuleId:    outcome.RuleID,
+				Scope:     string(outcome.Scope),
+				Passed:    outcome.Passed,
+				Message:   outcome.Message,
+				FieldPath: outcome.FieldPath,
+			})
+			if !outcome.Passed && outcome.Scope == validation.ScopeDeny {
+				valid = false
+			}
+			if !outcome.Passed && outcome.Scope == validation.ScopeDryRun {
+				h.logger.Info("dry-run rule failed",
+					zap.String("rule_id", outcome.RuleID),
+					zap.String("message", outcome.Message),
+				)
+			}
+		}
+	}
+
+	if valid {
+		h.observe(req.GetConstraints())
 	}
 
 	return &pb.ValidateServiceResponse{
-		Valid: true,
+		Valid:  valid,
+		Report: report,
 	}, nil
 }
 
+// structuralErrors unwraps ValidateServiceConstraintsStructural's return
+// value (an aggregated *validation.ValidationReport, also when constraints
+// is nil) into its component ValidationErrors.
+func structuralErrors(err error) []*validation.ValidationError {
+	switch e := err.(type) {
+	case *validation.ValidationReport:
+		return e.Errors
+	case *validation.ValidationError:
+		return []*validation.ValidationError{e}
+	default:
+		return nil
+	}
+}
+
+// observe records c as a recently-accepted constraints message, so a later
+// --dry-run UpdateValidationRules call has real samples to evaluate
+// candidate rules against. It's a no-op when no AdminService is configured.
+// Call it only after constraints have already passed validation.
+func (h *AdvisoryHandler) observe(c *pb.ServiceConstraints) {
+	if h.admin != nil {
+		h.admin.Observe(c)
+	}
+}
+
+// resolvePolicy merges the server-side default policy with a per-request
+// override, letting teams progressively roll out new rules (DRYRUN -> WARN
+// -> DENY) without every caller having to specify a policy.
+func resolvePolicy(base validation.Policy, req *pb.ValidationPolicy) validation.Policy {
+	if req == nil {
+		return base
+	}
+
+	merged := validation.Policy{
+		Default:   base.Default,
+		Overrides: map[string]validation.EnforcementScope{},
+	}
+	for id, scope := range base.Overrides {
+		merged.Overrides[id] = scope
+	}
+	if req.GetDefault() != "" {
+		merged.Default = validation.EnforcementScope(req.GetDefault())
+	}
+	for id, scope := range req.GetOverrides() {
+		merged.Overrides[id] = validation.EnforcementScope(scope)
+	}
+	return merged
+}
+
 // ═════════════════════════════════════════════════════════════════════════════
 // REGISTER SERVICE
 // ═════════════════════════════════════════════════════════════════════════════
@@ -212,24 +488,161 @@ func (h *AdvisoryHandler) RegisterService(
 		zap.Float64("impact_score", req.GetImpactScore()),
 	)
 
-	// Week 3: Log telemetry for observability
-	// Week 9-10: Store in database, update pattern confidence, calculate Ξ
+	score, err := xi.Calculate(req.GetRelevanceScore(), req.GetActionabilityScore(), req.GetImpactScore())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid Ξ component: %v", err)
+	}
+	qualityLevel := xi.QualityLevel(score.Overall)
+
+	if h.xiAggregator != nil {
+		h.xiAggregator.ObserveScore(req.GetServiceName(), time.Now(), *score)
+	}
 
-	// Mock quality level determination
-	overallScore := (req.GetRelevanceScore() + req.GetActionabilityScore() + req.GetImpactScore()) / 3.0
-	qualityLevel := determineQualityLevel(overallScore)
+	h.persistRegistration(ctx, req, score)
+	h.blueprintPatterns.Delete(req.GetBlueprintId())
 
 	h.logger.Info("Service registered",
 		zap.String("blueprint_id", req.GetBlueprintId()),
-		zap.Float64("overall_score", overallScore),
+		zap.Float64("overall_score", score.Overall),
 		zap.String("quality_level", qualityLevel),
 	)
 
 	return &pb.RegisterServiceResponse{
-		Success:       true,
-		Message:       fmt.Sprintf("Service registered successfully. Quality level: %s", qualityLevel),
-		OverallScore:  overallScore,
-		QualityLevel:  qualityLevel,
+		Success:      true,
+		Message:      fmt.Sprintf("Service registered successfully. Quality level: %s", qualityLevel),
+		OverallScore: score.Overall,
+		QualityLevel: qualityLevel,
+	}, nil
+}
+
+// persistRegistration writes req to h.store and feeds it through
+// h.confidenceUpdater, logging rather than failing the RPC on error: a
+// telemetry write hiccup shouldn't block a team from registering their
+// service. Both are no-ops when no store was configured.
+func (h *AdvisoryHandler) persistRegistration(ctx context.Context, req *pb.RegisterServiceRequest, score *xi.Score) {
+	if h.store == nil {
+		return
+	}
+
+	recommended, _ := h.blueprintPatterns.Load(req.GetBlueprintId())
+	recommendedEntry, _ := recommended.(blueprintPatternsEntry)
+	recommendedIDs := recommendedEntry.patternIDs
+
+	r := telemetry.Registration{
+		BlueprintID:         req.GetBlueprintId(),
+		ServiceName:         req.GetServiceName(),
+		RecommendedPatterns: recommendedIDs,
+		AppliedPatterns:     req.GetAppliedPatternIds(),
+		Score:               *score,
+		Incidents:           convertIncidents(req.GetIncidents()),
+		RegisteredAt:        time.Now(),
+	}
+
+	if err := h.store.RecordRegistration(ctx, r); err != nil {
+		h.logger.Error("failed to persist registration telemetry",
+			zap.String("blueprint_id", req.GetBlueprintId()), zap.Error(err))
+		return
+	}
+
+	if h.confidenceUpdater == nil {
+		return
+	}
+	if err := h.confidenceUpdater.Observe(ctx, r); err != nil {
+		h.logger.Error("failed to update pattern confidence",
+			zap.String("blueprint_id", req.GetBlueprintId()), zap.Error(err))
+	}
+}
+
+// convertIncidents adapts the wire Incident type to xi.Incident.
+func convertIncidents(incidents []*pb.Incident) []xi.Incident {
+	out := make([]xi.Incident, len(incidents))
+	for i, in := range incidents {
+		out[i] = xi.Incident{
+			Severity:        in.GetSeverity(),
+			Description:     in.GetDescription(),
+			DurationMinutes: int(in.GetDurationMinutes()),
+		}
+	}
+	return out
+}
+
+// GetServiceHistory returns a service's time-ordered registration history
+// plus a rolling-geometric-mean Ξ trend (see telemetry.Trend), so operators
+// can see whether a service's advisory quality is improving or regressing
+// over successive blueprint/register cycles rather than just its latest
+// score.
+func (h *AdvisoryHandler) GetServiceHistory(
+	ctx context.Context,
+	req *pb.GetServiceHistoryRequest,
+) (*pb.GetServiceHistoryResponse, error) {
+	if h.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "telemetry store is not configured")
+	}
+
+	var (
+		history []telemetry.Registration
+		err     error
+	)
+	switch {
+	case req.GetBlueprintId() != "":
+		var r *telemetry.Registration
+		r, err = h.store.HistoryByBlueprint(ctx, req.GetBlueprintId())
+		if err == nil {
+			history = []telemetry.Registration{*r}
+		}
+	case req.GetServiceName() != "":
+		history, err = h.store.HistoryByService(ctx, req.GetServiceName())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "blueprint_id or service_name is required")
+	}
+
+	if err == telemetry.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "no history for %q", req.GetBlueprintId())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "loading service history: %v", err)
+	}
+
+	points := telemetry.Trend(history)
+	resp := &pb.GetServiceHistoryResponse{
+		Points: make([]*pb.XiTrendPoint, len(points)),
+	}
+	for i, p := range points {
+		resp.Points[i] = &pb.XiTrendPoint{
+			BlueprintId:   p.Registration.BlueprintID,
+			Relevance:     p.Registration.Score.Relevance,
+			Actionability: p.Registration.Score.Actionability,
+			Impact:        p.Registration.Score.Impact,
+			Overall:       p.Registration.Score.Overall,
+			RollingMean:   p.RollingMean,
+			RegisteredAt:  p.Registration.RegisteredAt.Unix(),
+		}
+	}
+	return resp, nil
+}
+
+// GetPatternConfidence returns the current Beta-posterior confidence for a
+// pattern, so mockRecommendedPatterns (and its real replacement) can source
+// confidences from live registration feedback instead of hard-coded
+// constants.
+func (h *AdvisoryHandler) GetPatternConfidence(
+	ctx context.Context,
+	req *pb.GetPatternConfidenceRequest,
+) (*pb.GetPatternConfidenceResponse, error) {
+	if h.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "telemetry store is not configured")
+	}
+
+	c, err := h.store.PatternConfidence(ctx, req.GetPatternId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "loading pattern confidence: %v", err)
+	}
+
+	return &pb.GetPatternConfidenceResponse{
+		PatternId:  c.PatternID,
+		Alpha:      c.Alpha,
+		Beta:       c.Beta,
+		Confidence: c.Mean(),
 	}, nil
 }
 
@@ -296,9 +709,32 @@ func (h *AdvisoryHandler) mockRecommendedPatterns(constraints *pb.ServiceConstra
 		})
 	}
 
+	h.applyLiveConfidence(patterns)
 	return patterns
 }
 
+// applyLiveConfidence overrides each pattern's hard-coded Confidence with
+// its posterior mean from the telemetry store, but only once that posterior
+// reflects real trials (Alpha+Beta > 2, i.e. more than the untouched
+// Beta(1,1) prior) — otherwise a brand-new pattern would regress from its
+// curated starting confidence to a flat 0.5 before any feedback exists.
+func (h *AdvisoryHandler) applyLiveConfidence(patterns []*pb.RecommendedPattern) {
+	if h.store == nil {
+		return
+	}
+	for _, p := range patterns {
+		c, err := h.store.PatternConfidence(context.Background(), p.GetPatternId())
+		if err != nil {
+			h.logger.Warn("failed to load live pattern confidence",
+				zap.String("pattern_id", p.GetPatternId()), zap.Error(err))
+			continue
+		}
+		if c.Alpha+c.Beta > 2 {
+			p.Confidence = c.Mean()
+		}
+	}
+}
+
 // mockGeneratedArtifacts generates mock code artifacts.
 func (h *AdvisoryHandler) mockGeneratedArtifacts(constraints *pb.ServiceConstraints) []*pb.Artifact {
 	serviceName := constraints.GetServiceName()
@@ -341,18 +777,7 @@ func generateBlueprintID(serviceName string) string {
 	return fmt.Sprintf("bp-%s-%d", serviceName, time.Now().Unix())
 }
 
-// determineQualityLevel converts numeric score to quality level string.
-func determineQualityLevel(score float64) string {
-	switch {
-	case score >= 0.85:
-		return "EXCELLENT"
-	case score >= 0.75:
-		return "VERY GOOD"
-	case score >= 0.65:
-		return "GOOD"
-	case score >= 0.50:
-		return "ACCEPTABLE"
-	default:
-		return "NEEDS IMPROVEMENT"
-	}
-}
+// Quality level labeling now goes through xi.QualityLevel (see
+// RegisterService), so the gRPC handler, the xi package, and any CLI that
+// imports xi directly all read off the same Config.QualityThresholds
+// instead of maintaining separate copies of the scale.
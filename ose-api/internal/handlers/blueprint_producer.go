@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+)
+
+// artifactChunkSize is the maximum Content size per ArtifactGenerated event;
+// larger artifacts are split across multiple events so no single gRPC
+// message exceeds it.
+const artifactChunkSize = 64 * 1024
+
+// BlueprintProducer generates the stages of a Blueprint as a stream of
+// events, so GenerateBlueprintStream can forward them to the client as soon
+// as each stage completes rather than waiting for the whole blueprint.
+//
+// The mock implementation below satisfies this interface today; Week 6-8's
+// real Pattern Graph / Template Engine integration will satisfy it by
+// replacing mockBlueprintProducer, with no change required to
+// GenerateBlueprintStream or the unary GenerateBlueprint wrapper.
+type BlueprintProducer interface {
+	// Produce emits one *pb.BlueprintEvent per stage (ValidationCompleted,
+	// one PatternRecommended per pattern, one ArtifactGenerated per
+	// artifact/chunk, PerformanceTargetsComputed, and a terminal
+	// BlueprintFinalized) onto the returned channel, which is closed when
+	// production finishes or ctx is cancelled. Errors are sent on the
+	// second channel, which is closed at the same time as the first.
+	Produce(ctx context.Context, constraints *pb.ServiceConstraints) (<-chan *pb.BlueprintEvent, <-chan error)
+}
+
+// mockBlueprintProducer adapts AdvisoryHandler's existing mock*
+// helpers to the BlueprintProducer interface. It is the default producer
+// until Week 6-8 ships the real implementation.
+type mockBlueprintProducer struct {
+	handler *AdvisoryHandler
+}
+
+func newMockBlueprintProducer(h *AdvisoryHandler) *mockBlueprintProducer {
+	return &mockBlueprintProducer{handler: h}
+}
+
+func (p *mockBlueprintProducer) Produce(
+	ctx context.Context,
+	constraints *pb.ServiceConstraints,
+) (<-chan *pb.BlueprintEvent, <-chan error) {
+	events := make(chan *pb.BlueprintEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		send := func(ev *pb.BlueprintEvent) bool {
+			select {
+			case events <- ev:
+				return true
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return false
+			}
+		}
+
+		if !send(&pb.BlueprintEvent{
+			Event: &pb.BlueprintEvent_ValidationCompleted{
+				ValidationCompleted: &pb.ValidationCompleted{},
+			},
+		}) {
+			return
+		}
+
+		for _, pattern := range p.handler.mockRecommendedPatterns(constraints) {
+			if !send(&pb.BlueprintEvent{
+				Event: &pb.BlueprintEvent_PatternRecommended{PatternRecommended: pattern},
+			}) {
+				return
+			}
+		}
+
+		for _, artifact := range p.handler.mockGeneratedArtifacts(constraints) {
+			for _, chunk := range chunkArtifact(artifact) {
+				if !send(&pb.BlueprintEvent{
+					Event: &pb.BlueprintEvent_ArtifactGenerated{ArtifactGenerated: chunk},
+				}) {
+					return
+				}
+			}
+		}
+
+		targets := p.handler.mockPerformanceTargets(constraints)
+		if !send(&pb.BlueprintEvent{
+			Event: &pb.BlueprintEvent_PerformanceTargetsComputed{PerformanceTargetsComputed: targets},
+		}) {
+			return
+		}
+
+		blueprintID := generateBlueprintID(constraints.GetServiceName())
+		send(&pb.BlueprintEvent{
+			Event: &pb.BlueprintEvent_BlueprintFinalized{
+				BlueprintFinalized: &pb.BlueprintFinalized{
+					BlueprintId: blueprintID,
+					GeneratedAt: time.Now().Unix(),
+				},
+			},
+		})
+	}()
+
+	return events, errs
+}
+
+// chunkArtifact splits an artifact's Content into <=64KB pieces, each
+// represented as a copy of the artifact with the chunked slice. An artifact
+// under the limit is returned as a single-element slice.
+func chunkArtifact(artifact *pb.Artifact) []*pb.Artifact {
+	content := artifact.GetContent()
+	if len(content) <= artifactChunkSize {
+		return []*pb.Artifact{artifact}
+	}
+
+	chunks := make([]*pb.Artifact, 0, (len(content)/artifactChunkSize)+1)
+	for start := 0; start < len(content); start += artifactChunkSize {
+		end := start + artifactChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+		chunk := *artifact
+		chunk.Content = content[start:end]
+		chunks = append(chunks, &chunk)
+	}
+	return chunks
+}
+
+// drainProducer consumes a BlueprintProducer's channels to completion,
+// assembling the stages back into a single Blueprint. This is the shared
+// plumbing behind the unary GenerateBlueprint wrapper.
+func drainProducer(events <-chan *pb.BlueprintEvent, errs <-chan error) (*pb.Blueprint, error) {
+	blueprint := &pb.Blueprint{}
+
+	for ev := range events {
+		switch e := ev.GetEvent().(type) {
+		case *pb.BlueprintEvent_ValidationCompleted:
+			// nothing to accumulate
+		case *pb.BlueprintEvent_PatternRecommended:
+			blueprint.Patterns = append(blueprint.Patterns, e.PatternRecommended)
+		case *pb.BlueprintEvent_ArtifactGenerated:
+			blueprint.Artifacts = appendOrMergeArtifact(blueprint.Artifacts, e.ArtifactGenerated)
+		case *pb.BlueprintEvent_PerformanceTargetsComputed:
+			blueprint.PerformanceTargets = e.PerformanceTargetsComputed
+		case *pb.BlueprintEvent_BlueprintFinalized:
+			blueprint.BlueprintId = e.BlueprintFinalized.GetBlueprintId()
+			blueprint.GeneratedAt = e.BlueprintFinalized.GetGeneratedAt()
+		default:
+			return nil, fmt.Errorf("unknown blueprint event type %T", e)
+		}
+	}
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	return blueprint, nil
+}
+
+// appendOrMergeArtifact reassembles a chunked artifact (same Path) back into
+// one entry, since the stream may have split it across multiple events.
+func appendOrMergeArtifact(artifacts []*pb.Artifact, chunk *pb.Artifact) []*pb.Artifact {
+	for _, existing := range artifacts {
+		if existing.GetPath() == chunk.GetPath() {
+			existing.Content = append(existing.Content, chunk.GetContent()...)
+			return artifacts
+		}
+	}
+	return append(artifacts, chunk)
+}
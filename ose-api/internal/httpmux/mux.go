@@ -0,0 +1,53 @@
+// Package httpmux multiplexes native gRPC, gRPC-Web, and plain HTTP/1.1
+// traffic onto a single net.Listener — the way Connect and flagd bind
+// everything to one socket — as an alternative to this server's default
+// split-port topology (gRPC on GRPCAddress, REST/health/metrics on
+// HTTPAddress). See config.Config.SinglePort.
+package httpmux
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+)
+
+// New returns an http.Handler that dispatches each request to grpcServer
+// (native gRPC, detected by an HTTP/2 request with a Content-Type of
+// application/grpc*), a gRPC-Web wrapper around grpcServer (browser
+// clients that can't speak HTTP/2 trailers directly), or httpHandler
+// (everything else: REST, /health, /ready, /metrics).
+//
+// The returned handler must be served behind Serve so HTTP/2 requests
+// (native gRPC) arrive correctly without TLS.
+func New(grpcServer *grpc.Server, httpHandler http.Handler) http.Handler {
+	grpcWeb := grpcweb.WrapServer(grpcServer)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case isGRPCRequest(r):
+			grpcServer.ServeHTTP(w, r)
+		case grpcWeb.IsGrpcWebRequest(r) || grpcWeb.IsAcceptableGrpcCorsRequest(r):
+			grpcWeb.ServeHTTP(w, r)
+		default:
+			httpHandler.ServeHTTP(w, r)
+		}
+	})
+}
+
+// isGRPCRequest reports whether r is a native gRPC request: HTTP/2 with a
+// Content-Type of application/grpc, optionally suffixed (+proto, +json).
+func isGRPCRequest(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc")
+}
+
+// Serve wraps handler in an h2c.Handler so the listener accepts HTTP/2
+// connections — both native gRPC and h2c-aware HTTP clients — without
+// requiring TLS, matching how this service expects cleartext traffic
+// terminated upstream (ingress, service mesh) rather than at the process.
+func Serve(handler http.Handler) http.Handler {
+	return h2c.NewHandler(handler, &http2.Server{})
+}
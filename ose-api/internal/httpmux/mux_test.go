@@ -0,0 +1,37 @@
+package httpmux
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsGRPCRequestRequiresHTTP2AndGRPCContentType(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/advisory.v1.AdvisoryService/GenerateBlueprint", nil)
+	req.ProtoMajor = 2
+	req.Header.Set("Content-Type", "application/grpc+proto")
+
+	if !isGRPCRequest(req) {
+		t.Error("expected HTTP/2 request with application/grpc+proto to be detected as gRPC")
+	}
+}
+
+func TestIsGRPCRequestRejectsHTTP1(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/advisory.v1.AdvisoryService/GenerateBlueprint", nil)
+	req.ProtoMajor = 1
+	req.Header.Set("Content-Type", "application/grpc")
+
+	if isGRPCRequest(req) {
+		t.Error("expected HTTP/1.1 request to not be detected as native gRPC")
+	}
+}
+
+func TestIsGRPCRequestRejectsOtherContentTypes(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.ProtoMajor = 2
+	req.Header.Set("Content-Type", "text/plain")
+
+	if isGRPCRequest(req) {
+		t.Error("expected non-gRPC content type to not be detected as gRPC")
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// loggerContextKey is unexported so only withLogger/LoggerFromContext can
+// set or read the request-scoped logger attached by RequestLogger's
+// interceptors.
+type loggerContextKey struct{}
+
+func withLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// LoggerFromContext returns the request-scoped logger attached by
+// RequestLogger's interceptor chain, already carrying the method field (plus
+// service_name/blueprint_id for unary RPCs), or fallback if the context
+// carries none (e.g. in
+// tests that call handler methods directly without the interceptor chain).
+func LoggerFromContext(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
+}
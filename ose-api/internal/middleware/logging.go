@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"reflect"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// RequestLogger returns a Decorator that attaches blueprint_id/service_name/
+// method fields, plus trace_id/span_id when Tracing ran earlier in the
+// Pipeline and the request carries a sampled span, to a request-scoped
+// zap.Logger reachable via LoggerFromContext, so handler log calls become
+// correlated without every call site having to thread request metadata by
+// hand. Streaming RPCs get only the method (and trace) fields, since the
+// stream interceptor runs before any request message has been received.
+func RequestLogger(logger *zap.Logger) Decorator {
+	return Decorator{
+		Name:   "request-logger",
+		Unary:  requestLoggerUnaryInterceptor(logger),
+		Stream: requestLoggerStreamInterceptor(logger),
+	}
+}
+
+func requestLoggerUnaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		scoped := logger.With(
+			zap.String("method", info.FullMethod),
+			zap.String("service_name", serviceNameOf(req)),
+		)
+		if id := blueprintIDOf(req); id != "" {
+			scoped = scoped.With(zap.String("blueprint_id", id))
+		}
+		scoped = withTraceFields(ctx, scoped)
+		return handler(withLogger(ctx, scoped), req)
+	}
+}
+
+func requestLoggerStreamInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := ss.Context()
+		scoped := withTraceFields(ctx, logger.With(zap.String("method", info.FullMethod)))
+		return handler(srv, &loggingServerStream{ServerStream: ss, ctx: withLogger(ctx, scoped)})
+	}
+}
+
+// withTraceFields adds trace_id/span_id fields to logger when ctx carries a
+// valid span context (i.e. Tracing ran earlier in the Pipeline), so log
+// lines for an RPC can be correlated with the trace a collector recorded
+// for it. A ctx with no span (tracing disabled, or no TracerProvider
+// registered) leaves logger unchanged.
+func withTraceFields(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return logger
+	}
+	return logger.With(
+		zap.String("trace_id", sc.TraceID().String()),
+		zap.String("span_id", sc.SpanID().String()),
+	)
+}
+
+// loggingServerStream overrides Context() so downstream handlers observe the
+// request-scoped logger attached by requestLoggerStreamInterceptor.
+type loggingServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggingServerStream) Context() context.Context { return s.ctx }
+
+// serviceNameOf/blueprintIDOf extract common correlation fields from the
+// handful of request types AdvisoryHandler accepts. The generated request
+// types don't share an interface for these accessors, so we look them up by
+// method name via reflection rather than type-switching over every RPC's
+// request message.
+func serviceNameOf(req interface{}) string {
+	constraints := callNoArgMethod(req, "GetConstraints")
+	if constraints == nil {
+		return ""
+	}
+	if name := callNoArgMethod(constraints, "GetServiceName"); name != nil {
+		if s, ok := name.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+func blueprintIDOf(req interface{}) string {
+	if id := callNoArgMethod(req, "GetBlueprintId"); id != nil {
+		if s, ok := id.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+// callNoArgMethod invokes a zero-argument, single-return-value method on v
+// by name, returning nil if v is nil or has no such method.
+func callNoArgMethod(v interface{}, method string) interface{} {
+	if v == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	m := rv.MethodByName(method)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil
+	}
+	out := m.Call(nil)
+	return out[0].Interface()
+}
@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics returns a Decorator exporting Prometheus counters/histogram/gauge
+// for every RPC handled on the server, registered against reg. services
+// lists the grpc.ServiceDesc of every service registered on the server (e.g.
+// pb.AdvisoryService_ServiceDesc); its Methods/Streams are introspected to
+// pre-initialize every method's metric series at zero, so /metrics shows a
+// stable set of series from process start rather than only after each
+// method's first call.
+func Metrics(reg prometheus.Registerer, services ...grpc.ServiceDesc) Decorator {
+	m := newGRPCMetrics(reg)
+	for _, desc := range services {
+		m.preInitialize(desc)
+	}
+	return Decorator{
+		Name:   "metrics",
+		Unary:  m.unaryInterceptor(),
+		Stream: m.streamInterceptor(),
+	}
+}
+
+// grpcMetrics holds the per-method Prometheus series shared by the unary and
+// stream interceptors.
+type grpcMetrics struct {
+	requestsTotal   *prometheus.CounterVec
+	handlingSeconds *prometheus.HistogramVec
+	streamsTotal    *prometheus.CounterVec
+	inFlight        *prometheus.GaugeVec
+}
+
+func newGRPCMetrics(reg prometheus.Registerer) *grpcMetrics {
+	m := &grpcMetrics{
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "ose",
+			Subsystem: "grpc",
+			Name:      "server_handled_total",
+			Help:      "Total number of unary RPCs completed, by method and final gRPC code.",
+		}, []string{"method", "code"}),
+		handlingSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "ose",
+			Subsystem: "grpc",
+			Name:      "server_handling_seconds",
+			Help:      "Unary RPC handling duration in seconds, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		streamsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "ose",
+			Subsystem: "grpc",
+			Name:      "server_streams_handled_total",
+			Help:      "Total number of streaming RPCs completed, by method and final gRPC code.",
+		}, []string{"method", "code"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "ose",
+			Subsystem: "grpc",
+			Name:      "server_in_flight_requests",
+			Help:      "Number of RPCs (unary or streaming) currently being handled, by method.",
+		}, []string{"method"}),
+	}
+
+	if reg != nil {
+		reg.MustRegister(m.requestsTotal, m.handlingSeconds, m.streamsTotal, m.inFlight)
+	}
+
+	return m
+}
+
+// preInitialize materializes every metric series for desc's methods and
+// streams at their zero value (codes.OK for the counters, 0 for the gauge
+// and histogram), so they appear in /metrics from process start instead of
+// only after each method's first call. Any other gRPC code a method later
+// returns still creates its own series on first occurrence, same as today.
+func (m *grpcMetrics) preInitialize(desc grpc.ServiceDesc) {
+	for _, method := range desc.Methods {
+		fullMethod := fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)
+		m.requestsTotal.WithLabelValues(fullMethod, codes.OK.String())
+		m.handlingSeconds.WithLabelValues(fullMethod)
+		m.inFlight.WithLabelValues(fullMethod)
+	}
+	for _, stream := range desc.Streams {
+		fullMethod := fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)
+		m.streamsTotal.WithLabelValues(fullMethod, codes.OK.String())
+		m.inFlight.WithLabelValues(fullMethod)
+	}
+}
+
+func (m *grpcMetrics) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		m.inFlight.WithLabelValues(info.FullMethod).Inc()
+		defer m.inFlight.WithLabelValues(info.FullMethod).Dec()
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		m.observeDuration(info.FullMethod, time.Since(start).Seconds(), exemplarLabels(ctx))
+		m.requestsTotal.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+		return resp, err
+	}
+}
+
+func (m *grpcMetrics) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		m.inFlight.WithLabelValues(info.FullMethod).Inc()
+		defer m.inFlight.WithLabelValues(info.FullMethod).Dec()
+
+		err := handler(srv, ss)
+		m.streamsTotal.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return err
+	}
+}
+
+// observeDuration records seconds for method, attaching exemplar as a
+// Prometheus exemplar when the histogram implementation supports it
+// (native histograms do; classic ones only accept one exemplar per bucket)
+// and the caller supplied one.
+func (m *grpcMetrics) observeDuration(method string, seconds float64, exemplar prometheus.Labels) {
+	obs := m.handlingSeconds.WithLabelValues(method)
+	if eo, ok := obs.(prometheus.ExemplarObserver); ok && len(exemplar) > 0 {
+		eo.ObserveWithExemplar(seconds, exemplar)
+		return
+	}
+	obs.Observe(seconds)
+}
+
+// exemplarLabels extracts a trace_id exemplar label from ctx's span
+// context, populated by Tracing earlier in the Pipeline, or nil if ctx
+// carries no valid span (tracing disabled, or no sampled span for this
+// request).
+func exemplarLabels(ctx context.Context) prometheus.Labels {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return prometheus.Labels{"trace_id": sc.TraceID().String()}
+}
@@ -0,0 +1,150 @@
+// Package middleware builds the ordered chain of gRPC server interceptors —
+// panic recovery, request-scoped logging, Prometheus metrics, and any
+// deployment-specific middleware such as auth, rate limiting, request-ID
+// propagation, or tracing — that every service hosted by this binary runs
+// through.
+//
+// A Pipeline applies its Decorators in the order they're passed to New: the
+// first one listed is the outermost wrapper around the handler, the last one
+// the innermost. Recovery should therefore be listed first (it must have the
+// last word over a panicking handler), and Tracing before RequestLogger and
+// Metrics (so they can read the span it attaches). Metrics observes only the
+// status codes returned by the handler and the Decorators listed after it.
+// Each Decorator can opt out of specific
+// methods via its Match predicate, e.g. skipping auth on the health-check
+// service.
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// MethodMatcher reports whether a Decorator applies to the RPC named by
+// fullMethod (e.g. "/advisory.v1.AdvisoryService/GenerateBlueprint").
+type MethodMatcher func(fullMethod string) bool
+
+// All is the default MethodMatcher: a Decorator with no Match set applies to
+// every method.
+func All(string) bool { return true }
+
+// Except returns a MethodMatcher matching every method not in skip, for
+// opting a Decorator out of a handful of RPCs (e.g. auth skipping
+// grpc.health.v1.Health's Check/Watch).
+func Except(skip ...string) MethodMatcher {
+	skipped := make(map[string]struct{}, len(skip))
+	for _, method := range skip {
+		skipped[method] = struct{}{}
+	}
+	return func(fullMethod string) bool {
+		_, ok := skipped[fullMethod]
+		return !ok
+	}
+}
+
+// Decorator is one independently toggleable unit of server middleware: a
+// unary interceptor, a stream interceptor, or both, gated by Match so it can
+// apply to only a subset of methods instead of unconditionally. Either
+// interceptor may be left nil if the Decorator doesn't apply to that call
+// type (e.g. a unary-only rate limiter).
+type Decorator struct {
+	// Name identifies the Decorator in logs and diagnostics.
+	Name string
+	// Match reports whether this Decorator applies to a given RPC. A nil
+	// Match is treated as All.
+	Match  MethodMatcher
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+func (d Decorator) matches(fullMethod string) bool {
+	if d.Match == nil {
+		return true
+	}
+	return d.Match(fullMethod)
+}
+
+// Pipeline chains a declared-order list of Decorators into the
+// grpc.ServerOptions needed to install all of them on a grpc.Server.
+type Pipeline struct {
+	decorators []Decorator
+}
+
+// New builds a Pipeline from decorators, applied in the given order, the
+// first listed outermost. Pass Recovery first and Tracing before
+// RequestLogger and Metrics (see the package doc), then any
+// deployment-specific Decorators (auth, rate limiting, request-ID
+// propagation).
+func New(decorators ...Decorator) *Pipeline {
+	return &Pipeline{decorators: decorators}
+}
+
+// Options returns the grpc.ServerOptions that install every Decorator in
+// this Pipeline, for callers that need to fold them in with other
+// grpc.ServerOptions (TLS credentials, keepalive parameters, ...) themselves.
+func (p *Pipeline) Options() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(p.unaryInterceptors()...),
+		grpc.ChainStreamInterceptor(p.streamInterceptors()...),
+	}
+}
+
+// Apply constructs a grpc.Server with this Pipeline installed alongside any
+// additional opts.
+func (p *Pipeline) Apply(opts ...grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(append(p.Options(), opts...)...)
+}
+
+func (p *Pipeline) unaryInterceptors() []grpc.UnaryServerInterceptor {
+	var out []grpc.UnaryServerInterceptor
+	for _, d := range p.decorators {
+		if d.Unary == nil {
+			continue
+		}
+		out = append(out, gateUnary(d))
+	}
+	return out
+}
+
+func (p *Pipeline) streamInterceptors() []grpc.StreamServerInterceptor {
+	var out []grpc.StreamServerInterceptor
+	for _, d := range p.decorators {
+		if d.Stream == nil {
+			continue
+		}
+		out = append(out, gateStream(d))
+	}
+	return out
+}
+
+// gateUnary wraps d.Unary so it's skipped entirely for methods d opts out
+// of via Match, rather than every Decorator's interceptor having to
+// remember to check Match itself.
+func gateUnary(d Decorator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !d.matches(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		return d.Unary(ctx, req, info, handler)
+	}
+}
+
+func gateStream(d Decorator) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !d.matches(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return d.Stream(srv, ss, info, handler)
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestExceptSkipsListedMethods(t *testing.T) {
+	match := Except("/grpc.health.v1.Health/Check")
+
+	if match("/grpc.health.v1.Health/Check") {
+		t.Error("expected Check to be excluded")
+	}
+	if !match("/advisory.v1.AdvisoryService/GenerateBlueprint") {
+		t.Error("expected GenerateBlueprint to still match")
+	}
+}
+
+func TestPipelineSkipsDecoratorForNonMatchingMethod(t *testing.T) {
+	var called bool
+	d := Decorator{
+		Name:  "auth",
+		Match: Except("/grpc.health.v1.Health/Check"),
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			called = true
+			return handler(ctx, req)
+		},
+	}
+	p := New(d)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	interceptors := p.unaryInterceptors()
+	if len(interceptors) != 1 {
+		t.Fatalf("expected 1 interceptor, got %d", len(interceptors))
+	}
+
+	if _, err := interceptors[0](context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if called {
+		t.Error("expected decorator to be skipped for excluded method")
+	}
+
+	if _, err := interceptors[0](context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/advisory.v1.AdvisoryService/GenerateBlueprint"}, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("expected decorator to run for non-excluded method")
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recovery returns a Decorator that converts a panicking handler into an
+// Internal status error instead of crashing the process, logging the panic
+// value and stack trace first.
+func Recovery(logger *zap.Logger) Decorator {
+	return Decorator{
+		Name:   "recovery",
+		Unary:  recoveryUnaryInterceptor(logger),
+		Stream: recoveryStreamInterceptor(logger),
+	}
+}
+
+func recoveryUnaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("panic recovered in RPC handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.String("stack", string(debug.Stack())),
+				)
+				err = status.Errorf(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func recoveryStreamInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("panic recovered in streaming RPC handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.String("stack", string(debug.Stack())),
+				)
+				err = status.Errorf(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
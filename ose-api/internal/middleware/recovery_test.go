@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryInterceptorConvertsPanicToInternalStatus(t *testing.T) {
+	interceptor := recoveryUnaryInterceptor(zap.NewNop())
+	panickingHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	}
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/advisory.v1.AdvisoryService/GenerateBlueprint"}, panickingHandler)
+	if resp != nil {
+		t.Errorf("expected nil response, got %v", resp)
+	}
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("expected a gRPC status error, got %v", err)
+	}
+	if st.Code() != codes.Internal {
+		t.Errorf("code = %v, want %v", st.Code(), codes.Internal)
+	}
+}
+
+func TestRecoveryUnaryInterceptorPassesThroughNormalHandler(t *testing.T) {
+	interceptor := recoveryUnaryInterceptor(zap.NewNop())
+	wantResp := "ok"
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return wantResp, nil
+	}
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/advisory.v1.AdvisoryService/SearchPatterns"}, handler)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp != wantResp {
+		t.Errorf("resp = %v, want %v", resp, wantResp)
+	}
+}
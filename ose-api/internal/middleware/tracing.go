@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+)
+
+// Tracing returns a Decorator installing OpenTelemetry's otelgrpc
+// interceptors: they extract W3C tracecontext from incoming metadata (or
+// start a new trace if none is present), start a span per RPC tagged with
+// rpc.system/rpc.service/rpc.method and the resulting status code, and end
+// it when the handler returns.
+//
+// List Tracing before RequestLogger and Metrics in the Pipeline so the span
+// it attaches to the request context is visible to RequestLogger's
+// trace_id/span_id fields and Metrics' exemplars. With no TracerProvider
+// registered (see tracing.NewProvider), otelgrpc falls back to the global
+// no-op tracer and this Decorator is inert.
+func Tracing() Decorator {
+	return Decorator{
+		Name:   "tracing",
+		Unary:  otelgrpc.UnaryServerInterceptor(),
+		Stream: otelgrpc.StreamServerInterceptor(),
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestTimeout returns a Decorator that bounds each unary RPC's context to
+// timeout(), read fresh on every call so a config reload (e.g. via SIGHUP)
+// takes effect for the next incoming request without restarting the server.
+// A non-positive timeout leaves the context unbounded.
+func RequestTimeout(timeout func() time.Duration) Decorator {
+	return Decorator{
+		Name: "request-timeout",
+		Unary: func(
+			ctx context.Context,
+			req interface{},
+			info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler,
+		) (interface{}, error) {
+			d := timeout()
+			if d <= 0 {
+				return handler(ctx, req)
+			}
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return handler(ctx, req)
+		},
+	}
+}
+
+// ConcurrencyLimit returns a Decorator that rejects a unary or streaming RPC
+// with ResourceExhausted once limit() requests are already in flight across
+// the whole server. limit is read fresh on every call, so lowering or
+// raising it (e.g. via SIGHUP) takes effect immediately. A non-positive
+// limit disables the check.
+func ConcurrencyLimit(limit func() int) Decorator {
+	var inFlight int64
+
+	acquire := func(limit int) bool {
+		if limit <= 0 {
+			return true
+		}
+		if int(atomic.AddInt64(&inFlight, 1)) > limit {
+			atomic.AddInt64(&inFlight, -1)
+			return false
+		}
+		return true
+	}
+	release := func(limit int) {
+		if limit <= 0 {
+			return
+		}
+		atomic.AddInt64(&inFlight, -1)
+	}
+
+	return Decorator{
+		Name: "concurrency-limit",
+		Unary: func(
+			ctx context.Context,
+			req interface{},
+			info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler,
+		) (interface{}, error) {
+			n := limit()
+			if !acquire(n) {
+				return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent requests (limit %d)", n)
+			}
+			defer release(n)
+			return handler(ctx, req)
+		},
+		Stream: func(
+			srv interface{},
+			ss grpc.ServerStream,
+			info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler,
+		) error {
+			n := limit()
+			if !acquire(n) {
+				return status.Errorf(codes.ResourceExhausted, "too many concurrent requests (limit %d)", n)
+			}
+			defer release(n)
+			return handler(srv, ss)
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConcurrencyLimitRejectsOverLimit(t *testing.T) {
+	d := ConcurrencyLimit(func() int { return 1 })
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	blocking := func(ctx context.Context, req interface{}) (interface{}, error) {
+		close(started)
+		<-release
+		return "ok", nil
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := d.Unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Blocking"}, blocking)
+		errCh <- err
+	}()
+	<-started
+
+	immediate := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	_, err := d.Unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Immediate"}, immediate)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected ResourceExhausted while at limit, got %v", err)
+	}
+
+	close(release)
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected error from blocking call: %v", err)
+	}
+}
+
+func TestConcurrencyLimitUnlimitedWhenNonPositive(t *testing.T) {
+	d := ConcurrencyLimit(func() int { return 0 })
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	for i := 0; i < 5; i++ {
+		if _, err := d.Unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Method"}, handler); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestRequestTimeoutBoundsContext(t *testing.T) {
+	d := RequestTimeout(func() time.Duration { return 10 * time.Millisecond })
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	_, err := d.Unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Slow"}, handler)
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
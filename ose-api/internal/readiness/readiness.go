@@ -0,0 +1,143 @@
+// Package readiness runs a set of dependency health checks in the
+// background and caches their result in an atomic snapshot, so the HTTP
+// /ready endpoint and the grpc.health.v1.Health service can both answer
+// "is this instance ready" in microseconds instead of dialing every
+// backend on each probe.
+package readiness
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Check reports whether a single dependency is healthy. It should respect
+// ctx's deadline rather than blocking indefinitely on a wedged backend.
+type Check func(ctx context.Context) error
+
+// Checker names a Check so its result can be reported individually in a
+// Snapshot (and, for /ready, in the response body).
+type Checker struct {
+	Name  string
+	Check Check
+}
+
+// Result is one Checker's outcome from the most recent run. Err is nil when
+// the check passed.
+type Result struct {
+	Name string
+	Err  error
+}
+
+// Snapshot is the cached outcome of running every registered Checker once.
+// Ready is true only when every Result's Err is nil.
+type Snapshot struct {
+	Ready   bool
+	Results []Result
+}
+
+// FailingNames returns the Name of every Result with a non-nil Err, in
+// registration order.
+func (s Snapshot) FailingNames() []string {
+	var names []string
+	for _, r := range s.Results {
+		if r.Err != nil {
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
+
+// Registry runs a fixed set of Checkers on a timer and exposes the latest
+// Snapshot for Ready/Current to read without blocking on the checks
+// themselves.
+type Registry struct {
+	checkers    []Checker
+	concurrency int
+	timeout     time.Duration
+
+	snapshot atomic.Pointer[Snapshot]
+}
+
+// NewRegistry returns a Registry over checkers. concurrency bounds how many
+// Checks run at once per cycle (so a handful of slow backends can't pile up
+// unbounded goroutines); values <= 0 default to 1. timeout bounds how long
+// any single Check may run before it's counted as failed.
+func NewRegistry(concurrency int, timeout time.Duration, checkers ...Checker) *Registry {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	r := &Registry{checkers: checkers, concurrency: concurrency, timeout: timeout}
+	// Until the first Run completes, Current reports this explicit
+	// not-ready Snapshot (Ready: false, no Results); storing it up front
+	// also means Current never dereferences a nil pointer.
+	r.snapshot.Store(&Snapshot{Ready: false})
+	return r
+}
+
+// Run evaluates every Checker once immediately, then again every interval,
+// until ctx is cancelled. After each cycle onUpdate (if non-nil) is called
+// with the resulting Snapshot, so callers such as the gRPC health service
+// can mirror it without polling Current themselves.
+func (r *Registry) Run(ctx context.Context, interval time.Duration, onUpdate func(Snapshot)) {
+	r.runOnce(ctx, onUpdate)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.runOnce(ctx, onUpdate)
+		}
+	}
+}
+
+func (r *Registry) runOnce(ctx context.Context, onUpdate func(Snapshot)) {
+	results := make([]Result, len(r.checkers))
+	sem := make(chan struct{}, r.concurrency)
+	done := make(chan struct{}, len(r.checkers))
+
+	for i, c := range r.checkers {
+		i, c := i, c
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; done <- struct{}{} }()
+			results[i] = Result{Name: c.Name, Err: r.runCheck(ctx, c)}
+		}()
+	}
+	for range r.checkers {
+		<-done
+	}
+
+	snapshot := Snapshot{Ready: true, Results: results}
+	for _, res := range results {
+		if res.Err != nil {
+			snapshot.Ready = false
+			break
+		}
+	}
+
+	r.snapshot.Store(&snapshot)
+	if onUpdate != nil {
+		onUpdate(snapshot)
+	}
+}
+
+func (r *Registry) runCheck(ctx context.Context, c Checker) error {
+	checkCtx := ctx
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	return c.Check(checkCtx)
+}
+
+// Current returns the most recently computed Snapshot. Before Run's first
+// cycle completes it reports Ready: false with no Results.
+func (r *Registry) Current() Snapshot {
+	return *r.snapshot.Load()
+}
@@ -0,0 +1,69 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRegistryReadyWhenAllChecksPass(t *testing.T) {
+	r := NewRegistry(2, time.Second,
+		Checker{Name: "a", Check: func(ctx context.Context) error { return nil }},
+		Checker{Name: "b", Check: func(ctx context.Context) error { return nil }},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.runOnce(ctx, nil)
+
+	snap := r.Current()
+	if !snap.Ready {
+		t.Fatalf("expected Ready, got %+v", snap)
+	}
+	if len(snap.FailingNames()) != 0 {
+		t.Errorf("expected no failing checks, got %v", snap.FailingNames())
+	}
+}
+
+func TestRegistryNotReadyWhenAnyCheckFails(t *testing.T) {
+	wantErr := errors.New("backend down")
+	r := NewRegistry(2, time.Second,
+		Checker{Name: "a", Check: func(ctx context.Context) error { return nil }},
+		Checker{Name: "b", Check: func(ctx context.Context) error { return wantErr }},
+	)
+
+	r.runOnce(context.Background(), nil)
+
+	snap := r.Current()
+	if snap.Ready {
+		t.Fatal("expected not Ready")
+	}
+	if got := snap.FailingNames(); len(got) != 1 || got[0] != "b" {
+		t.Errorf("expected failing checks [b], got %v", got)
+	}
+}
+
+func TestRegistryCurrentBeforeFirstRunIsNotReady(t *testing.T) {
+	r := NewRegistry(1, time.Second, Checker{Name: "a", Check: func(ctx context.Context) error { return nil }})
+
+	if snap := r.Current(); snap.Ready {
+		t.Error("expected Ready: false before the first Run cycle")
+	}
+}
+
+func TestRegistryCheckTimesOut(t *testing.T) {
+	r := NewRegistry(1, 10*time.Millisecond,
+		Checker{Name: "slow", Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+
+	r.runOnce(context.Background(), nil)
+
+	snap := r.Current()
+	if snap.Ready {
+		t.Fatal("expected not Ready after a timed-out check")
+	}
+}
@@ -0,0 +1,53 @@
+// Package tracing wires OpenTelemetry distributed tracing for the Advisory
+// Service: a TracerProvider that batches spans to an OTLP collector, and a
+// process-wide propagator so trace context arrives over gRPC metadata and
+// makes it into both spans and log lines.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// serviceName is reported on every span's resource attributes.
+const serviceName = "ose-advisory-service"
+
+// NewProvider builds a TracerProvider that batches spans to an OTLP/gRPC
+// collector at endpoint, and registers it (and a W3C tracecontext
+// propagator) as the process-wide default, so middleware.Tracing's
+// otelgrpc interceptors and any other otel.Tracer("...") caller use it
+// without being threaded through explicitly.
+//
+// Callers are responsible for calling TracerProvider.Shutdown during
+// graceful shutdown to flush any spans still buffered in the batcher.
+func NewProvider(ctx context.Context, endpoint string) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(endpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("tracing: creating OTLP exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("tracing: building resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return tp, nil
+}
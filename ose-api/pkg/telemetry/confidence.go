@@ -0,0 +1,41 @@
+package telemetry
+
+import "context"
+
+// successThreshold is the Impact score a registration must clear for its
+// applied patterns to count as a "success" trial, per the feedback loop
+// spec: a pattern earns confidence only when it was both applied and the
+// resulting service actually hit its performance targets.
+const successThreshold = 0.75
+
+// PatternConfidenceUpdater turns each registration into a Beta-posterior
+// update per recommended pattern: a trial succeeds when the pattern was in
+// the applied set and the registration's Impact score cleared
+// successThreshold, and fails otherwise (recommended-but-unapplied, or
+// applied-but-low-impact). The posterior mean α/(α+β) is what
+// GetPatternConfidence exposes and what mockRecommendedPatterns' real
+// replacement sources confidences from.
+type PatternConfidenceUpdater struct {
+	store Store
+}
+
+// NewPatternConfidenceUpdater constructs an updater writing to store.
+func NewPatternConfidenceUpdater(store Store) *PatternConfidenceUpdater {
+	return &PatternConfidenceUpdater{store: store}
+}
+
+// Observe records one Beta-posterior trial per pattern in r.RecommendedPatterns.
+func (u *PatternConfidenceUpdater) Observe(ctx context.Context, r Registration) error {
+	applied := make(map[string]bool, len(r.AppliedPatterns))
+	for _, id := range r.AppliedPatterns {
+		applied[id] = true
+	}
+
+	for _, patternID := range r.RecommendedPatterns {
+		success := applied[patternID] && r.Score.Impact >= successThreshold
+		if err := u.store.RecordPatternTrial(ctx, patternID, success); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package telemetry
+
+import "math"
+
+// TrendPoint pairs a registration's Overall Ξ score with the rolling
+// geometric mean of itself and the window-1 registrations before it, so
+// GetServiceHistory can show both the raw series and a smoothed trend line
+// in one response.
+type TrendPoint struct {
+	Registration Registration
+	RollingMean  float64
+}
+
+// rollingWindow is the number of trailing registrations averaged into each
+// TrendPoint's RollingMean.
+const rollingWindow = 5
+
+// Trend computes a rolling-geometric-mean trend line over history, which
+// must already be time-ordered oldest first (as returned by
+// Store.HistoryByService).
+func Trend(history []Registration) []TrendPoint {
+	points := make([]TrendPoint, len(history))
+	for i, r := range history {
+		start := i - rollingWindow + 1
+		if start < 0 {
+			start = 0
+		}
+		points[i] = TrendPoint{
+			Registration: r,
+			RollingMean:  geometricMeanOverall(history[start : i+1]),
+		}
+	}
+	return points
+}
+
+// geometricMeanOverall returns the geometric mean of Overall across window,
+// skipping non-positive scores (they count toward neither the product nor
+// the number of samples) rather than letting one zero out the whole
+// product, since a single bad registration shouldn't erase the trend for
+// the rest of the window. A window with no positive score yields 0.
+func geometricMeanOverall(window []Registration) float64 {
+	if len(window) == 0 {
+		return 0
+	}
+
+	logSum := 0.0
+	n := 0
+	for _, r := range window {
+		if r.Score.Overall <= 0 {
+			continue
+		}
+		logSum += math.Log(r.Score.Overall)
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return math.Exp(logSum / float64(n))
+}
@@ -0,0 +1,109 @@
+package telemetry
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// memoryStore is an in-process Store backed by plain maps, guarded by a
+// single mutex. It's intended for local development and tests; production
+// deployments use postgresStore (see postgres.go) for durability across
+// restarts.
+type memoryStore struct {
+	mu sync.Mutex
+
+	byBlueprint map[string]Registration
+	byService   map[string][]Registration
+	confidence  map[string]PatternConfidence
+}
+
+// NewMemoryStore constructs an in-memory Store with no persisted state.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		byBlueprint: make(map[string]Registration),
+		byService:   make(map[string][]Registration),
+		confidence:  make(map[string]PatternConfidence),
+	}
+}
+
+func (s *memoryStore) RecordRegistration(ctx context.Context, r Registration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.byBlueprint[r.BlueprintID] = r
+	s.byService[r.ServiceName] = append(s.byService[r.ServiceName], r)
+	return nil
+}
+
+func (s *memoryStore) HistoryByBlueprint(ctx context.Context, blueprintID string) (*Registration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.byBlueprint[blueprintID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &r, nil
+}
+
+func (s *memoryStore) HistoryByService(ctx context.Context, serviceName string) ([]Registration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := append([]Registration(nil), s.byService[serviceName]...)
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].RegisteredAt.Before(history[j].RegisteredAt)
+	})
+	return history, nil
+}
+
+func (s *memoryStore) AllRegistrations(ctx context.Context) ([]Registration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all := make([]Registration, 0, len(s.byBlueprint))
+	for _, r := range s.byBlueprint {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].RegisteredAt.Before(all[j].RegisteredAt)
+	})
+	return all, nil
+}
+
+func (s *memoryStore) PatternConfidence(ctx context.Context, patternID string) (PatternConfidence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.confidence[patternID]
+	if !ok {
+		return PatternConfidence{PatternID: patternID, Alpha: 1, Beta: 1}, nil
+	}
+	return c, nil
+}
+
+func (s *memoryStore) RecordPatternTrial(ctx context.Context, patternID string, success bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.confidence[patternID]
+	if !ok {
+		c = PatternConfidence{PatternID: patternID, Alpha: 1, Beta: 1}
+	}
+	if success {
+		c.Alpha++
+	} else {
+		c.Beta++
+	}
+	s.confidence[patternID] = c
+	return nil
+}
+
+func (s *memoryStore) ResetPatternConfidence(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.confidence = make(map[string]PatternConfidence)
+	return nil
+}
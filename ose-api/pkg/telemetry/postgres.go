@@ -0,0 +1,213 @@
+package telemetry
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	_ "github.com/lib/pq"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// postgresStore is the production Store implementation, backed by the
+// schema in migrations/0001_init.sql.
+type postgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore opens a connection pool to dsn and executes every
+// embedded migration (each is written with IF NOT EXISTS, so re-running it
+// on every start is harmless). dsn follows lib/pq's connection string
+// format; cmd/advisory-server reads it from OSE_TELEMETRY_DSN (see
+// newTelemetryStore there).
+func NewPostgresStore(ctx context.Context, dsn string) (Store, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry: opening postgres: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("telemetry: pinging postgres: %w", err)
+	}
+
+	s := &postgresStore{db: db}
+	if err := s.migrate(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *postgresStore) migrate(ctx context.Context) error {
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		return fmt.Errorf("telemetry: reading migrations: %w", err)
+	}
+	for _, entry := range entries {
+		sqlBytes, err := migrations.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return fmt.Errorf("telemetry: reading migration %s: %w", entry.Name(), err)
+		}
+		if _, err := s.db.ExecContext(ctx, string(sqlBytes)); err != nil {
+			return fmt.Errorf("telemetry: applying migration %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (s *postgresStore) RecordRegistration(ctx context.Context, r Registration) error {
+	recommended, err := json.Marshal(r.RecommendedPatterns)
+	if err != nil {
+		return err
+	}
+	applied, err := json.Marshal(r.AppliedPatterns)
+	if err != nil {
+		return err
+	}
+	incidents, err := json.Marshal(r.Incidents)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO telemetry_registrations
+			(blueprint_id, service_name, recommended_patterns, applied_patterns,
+			 relevance, actionability, impact, overall, incidents, registered_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (blueprint_id) DO UPDATE SET
+			applied_patterns = EXCLUDED.applied_patterns,
+			relevance        = EXCLUDED.relevance,
+			actionability    = EXCLUDED.actionability,
+			impact           = EXCLUDED.impact,
+			overall          = EXCLUDED.overall,
+			incidents        = EXCLUDED.incidents,
+			registered_at    = EXCLUDED.registered_at`,
+		r.BlueprintID, r.ServiceName, recommended, applied,
+		r.Score.Relevance, r.Score.Actionability, r.Score.Impact, r.Score.Overall,
+		incidents, r.RegisteredAt,
+	)
+	return err
+}
+
+func (s *postgresStore) HistoryByBlueprint(ctx context.Context, blueprintID string) (*Registration, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT blueprint_id, service_name, recommended_patterns, applied_patterns,
+		       relevance, actionability, impact, overall, incidents, registered_at
+		FROM telemetry_registrations WHERE blueprint_id = $1`, blueprintID)
+
+	r, err := scanRegistration(row)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *postgresStore) HistoryByService(ctx context.Context, serviceName string) ([]Registration, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT blueprint_id, service_name, recommended_patterns, applied_patterns,
+		       relevance, actionability, impact, overall, incidents, registered_at
+		FROM telemetry_registrations WHERE service_name = $1 ORDER BY registered_at ASC`, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanRegistrations(rows)
+}
+
+func (s *postgresStore) AllRegistrations(ctx context.Context) ([]Registration, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT blueprint_id, service_name, recommended_patterns, applied_patterns,
+		       relevance, actionability, impact, overall, incidents, registered_at
+		FROM telemetry_registrations ORDER BY registered_at ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanRegistrations(rows)
+}
+
+func (s *postgresStore) PatternConfidence(ctx context.Context, patternID string) (PatternConfidence, error) {
+	row := s.db.QueryRowContext(ctx,
+		`SELECT alpha, beta FROM telemetry_pattern_confidence WHERE pattern_id = $1`, patternID)
+
+	c := PatternConfidence{PatternID: patternID, Alpha: 1, Beta: 1}
+	err := row.Scan(&c.Alpha, &c.Beta)
+	if err == sql.ErrNoRows {
+		return c, nil
+	}
+	if err != nil {
+		return PatternConfidence{}, err
+	}
+	return c, nil
+}
+
+func (s *postgresStore) RecordPatternTrial(ctx context.Context, patternID string, success bool) error {
+	alphaDelta, betaDelta := 0.0, 1.0
+	if success {
+		alphaDelta, betaDelta = 1.0, 0.0
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO telemetry_pattern_confidence (pattern_id, alpha, beta)
+		VALUES ($1, 1 + $2, 1 + $3)
+		ON CONFLICT (pattern_id) DO UPDATE SET
+			alpha = telemetry_pattern_confidence.alpha + $2,
+			beta  = telemetry_pattern_confidence.beta + $3`,
+		patternID, alphaDelta, betaDelta,
+	)
+	return err
+}
+
+func (s *postgresStore) ResetPatternConfidence(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `TRUNCATE telemetry_pattern_confidence`)
+	return err
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows, letting
+// scanRegistration back both HistoryByBlueprint's single-row path and
+// scanRegistrations' multi-row path.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRegistration(row rowScanner) (*Registration, error) {
+	var (
+		r                               Registration
+		recommended, applied, incidents []byte
+	)
+	if err := row.Scan(
+		&r.BlueprintID, &r.ServiceName, &recommended, &applied,
+		&r.Score.Relevance, &r.Score.Actionability, &r.Score.Impact, &r.Score.Overall,
+		&incidents, &r.RegisteredAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(recommended, &r.RecommendedPatterns); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(applied, &r.AppliedPatterns); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(incidents, &r.Incidents); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func scanRegistrations(rows *sql.Rows) ([]Registration, error) {
+	var out []Registration
+	for rows.Next() {
+		r, err := scanRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *r)
+	}
+	return out, rows.Err()
+}
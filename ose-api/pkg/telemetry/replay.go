@@ -0,0 +1,30 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+)
+
+// Replay rebuilds store's pattern-confidence counters from scratch by
+// resetting them to the Beta(1,1) prior and then replaying every historical
+// registration through updater, in the order they were originally recorded.
+// This is the migration path for deployments that already have registration
+// history but predate the confidence feedback loop, and for recovering from
+// a counter corruption without losing the underlying registrations.
+func Replay(ctx context.Context, store Store, updater *PatternConfidenceUpdater) error {
+	if err := store.ResetPatternConfidence(ctx); err != nil {
+		return fmt.Errorf("telemetry: resetting pattern confidence: %w", err)
+	}
+
+	registrations, err := store.AllRegistrations(ctx)
+	if err != nil {
+		return fmt.Errorf("telemetry: loading registrations: %w", err)
+	}
+
+	for _, r := range registrations {
+		if err := updater.Observe(ctx, r); err != nil {
+			return fmt.Errorf("telemetry: replaying registration %s: %w", r.BlueprintID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Package telemetry persists the feedback OSE receives once a blueprint is
+// implemented: which patterns were actually applied, how the resulting
+// service scored on the Ξ dimensions, and what incidents followed. It is the
+// durable counterpart to pkg/xi's in-memory EWMA aggregation — where
+// xi.Aggregator answers "what is this service's quality right now", Store
+// answers "what has every registration ever told us", which is what backs
+// GetServiceHistory and the pattern-confidence feedback loop.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mysgeniels75-byte/ose-api/pkg/xi"
+)
+
+// ErrNotFound is returned by Store lookups that find no matching record.
+var ErrNotFound = errors.New("telemetry: not found")
+
+// Registration is one RegisterServiceRequest, persisted alongside the
+// recommended patterns from the blueprint it closes the loop on and the Ξ
+// score computed from it.
+type Registration struct {
+	BlueprintID         string
+	ServiceName         string
+	RecommendedPatterns []string
+	AppliedPatterns     []string
+	Score               xi.Score
+	Incidents           []xi.Incident
+	RegisteredAt        time.Time
+}
+
+// PatternConfidence is the Beta-distribution posterior maintained for one
+// pattern: Alpha counts registrations where the pattern was recommended,
+// applied, and scored well; Beta counts registrations where it was
+// recommended but either wasn't applied or scored poorly. Both start at 1
+// (a uniform Beta(1,1) prior) so a pattern with no history yields a
+// posterior mean of 0.5 rather than dividing by zero.
+type PatternConfidence struct {
+	PatternID string
+	Alpha     float64
+	Beta      float64
+}
+
+// Mean returns the posterior mean confidence, α/(α+β).
+func (c PatternConfidence) Mean() float64 {
+	if c.Alpha+c.Beta == 0 {
+		return 0
+	}
+	return c.Alpha / (c.Alpha + c.Beta)
+}
+
+// Store persists Registrations and the per-pattern confidence counters
+// derived from them. Implementations: memoryStore (tests, local dev) and
+// postgresStore (production — see postgres.go).
+type Store interface {
+	// RecordRegistration persists r. Callers supply RegisteredAt.
+	RecordRegistration(ctx context.Context, r Registration) error
+
+	// HistoryByBlueprint returns the registration for a single blueprint_id,
+	// or ErrNotFound if none exists.
+	HistoryByBlueprint(ctx context.Context, blueprintID string) (*Registration, error)
+
+	// HistoryByService returns every registration for serviceName,
+	// time-ordered oldest first.
+	HistoryByService(ctx context.Context, serviceName string) ([]Registration, error)
+
+	// AllRegistrations returns every registration ever recorded,
+	// time-ordered oldest first. Used by Replay to rebuild pattern
+	// confidence counters from scratch.
+	AllRegistrations(ctx context.Context) ([]Registration, error)
+
+	// PatternConfidence returns the current posterior for patternID,
+	// defaulting to Beta(1,1) if the pattern has no recorded trials.
+	PatternConfidence(ctx context.Context, patternID string) (PatternConfidence, error)
+
+	// RecordPatternTrial applies one Beta-posterior update for patternID:
+	// Alpha += 1 on success, Beta += 1 otherwise.
+	RecordPatternTrial(ctx context.Context, patternID string, success bool) error
+
+	// ResetPatternConfidence clears every pattern's counters back to the
+	// Beta(1,1) prior. Used by Replay before recomputing from history.
+	ResetPatternConfidence(ctx context.Context) error
+}
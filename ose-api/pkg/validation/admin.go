@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+)
+
+// RuleSource decodes a set of Rules from YAML or JSON, as loaded at startup
+// or received via the UpdateValidationRules admin RPC.
+type RuleSource interface {
+	Rules() ([]Rule, error)
+}
+
+// AdminService implements the UpdateValidationRules admin RPC
+// (api/proto/advisory/v1), letting operators push a new rule set without a
+// redeploy. It wraps an Engine plus a bounded ring of recently-accepted
+// constraints used to satisfy DryRun requests.
+type AdminService struct {
+	pb.UnimplementedValidationAdminServiceServer
+
+	engine  *Engine
+	maxKept int
+
+	mu     sync.Mutex
+	recent []*pb.ServiceConstraints
+}
+
+// NewAdminService constructs an AdminService backed by engine, retaining up
+// to maxKept recently-accepted constraints for --dry-run evaluation.
+func NewAdminService(engine *Engine, maxKept int) *AdminService {
+	if maxKept <= 0 {
+		maxKept = 200
+	}
+	return &AdminService{engine: engine, maxKept: maxKept}
+}
+
+// Observe records a constraints message that passed validation, so it can
+// later be used as a dry-run sample. Call this from the same path that
+// calls ValidateServiceConstraints successfully.
+func (s *AdminService) Observe(c *pb.ServiceConstraints) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.recent = append(s.recent, c)
+	if len(s.recent) > s.maxKept {
+		s.recent = s.recent[len(s.recent)-s.maxKept:]
+	}
+}
+
+// recentSnapshot returns a copy of the recently-observed constraints, safe
+// to range over without holding s.mu (s.recent can be reassigned by a
+// concurrent Observe).
+func (s *AdminService) recentSnapshot() []*pb.ServiceConstraints {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := make([]*pb.ServiceConstraints, len(s.recent))
+	copy(snapshot, s.recent)
+	return snapshot
+}
+
+// UpdateValidationRules compiles and, unless DryRun is set, promotes a new
+// rule set. In dry-run mode it instead evaluates the candidate rules against
+// the last N accepted requests and reports which would newly fail, without
+// mutating the live Engine.
+func (s *AdminService) UpdateValidationRules(
+	ctx context.Context,
+	req *pb.UpdateValidationRulesRequest,
+) (*pb.UpdateValidationRulesResponse, error) {
+	rules := make([]Rule, 0, len(req.GetRules()))
+	for _, r := range req.GetRules() {
+		rules = append(rules, Rule{
+			ID:                 r.GetId(),
+			Expression:         r.GetExpression(),
+			Severity:           Severity(r.GetSeverity()),
+			MessageTemplate:    r.GetMessage(),
+			SuggestionTemplate: r.GetSuggestion(),
+		})
+	}
+
+	if req.GetDryRun() {
+		failures, err := s.engine.DryRun(rules, s.recentSnapshot())
+		if err != nil {
+			return nil, fmt.Errorf("dry run: %w", err)
+		}
+
+		resp := &pb.UpdateValidationRulesResponse{DryRun: true}
+		for ruleID, services := range failures {
+			resp.DryRunFailures = append(resp.DryRunFailures, &pb.DryRunFailure{
+				RuleId:       ruleID,
+				ServiceNames: services,
+			})
+		}
+		return resp, nil
+	}
+
+	if err := s.engine.LoadRules(rules); err != nil {
+		return nil, fmt.Errorf("loading rules: %w", err)
+	}
+
+	return &pb.UpdateValidationRulesResponse{RulesLoaded: int32(len(rules))}, nil
+}
@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// Locale identifies a message catalog, e.g. "en", "es", "ja".
+type Locale string
+
+// English is the built-in, always-present default locale.
+const English Locale = "en"
+
+// MessageTemplate is a pair of `{{param}}`-style templates rendered against
+// a ValidationError's Params: one for the human message, one for the
+// constructive suggestion (may be empty).
+type MessageTemplate struct {
+	Message    string
+	Suggestion string
+}
+
+var catalogParamRegex = regexp.MustCompile(`\{\{(\w+)\}\}`)
+
+var (
+	catalogMu sync.RWMutex
+	catalogs  = map[Locale]map[string]MessageTemplate{
+		English: defaultEnglishCatalog(),
+	}
+)
+
+// RegisterCatalog installs (or replaces) the message catalog for lang,
+// letting callers localize ValidationError codes without touching
+// ValidateServiceConstraints itself. Passing English overrides the built-in
+// default catalog.
+func RegisterCatalog(lang Locale, msgs map[string]MessageTemplate) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	catalogs[lang] = msgs
+}
+
+// localeContextKey is unexported so only WithLocale/localeFromContext can
+// set or read it.
+type localeContextKey struct{}
+
+// WithLocale returns a context carrying the given locale, consulted by
+// ValidationError.Render (and transitively Error()) to pick a message
+// catalog.
+func WithLocale(ctx context.Context, lang Locale) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, lang)
+}
+
+// localeFromContext extracts the locale set by WithLocale, defaulting to
+// English.
+func localeFromContext(ctx context.Context) Locale {
+	if ctx == nil {
+		return English
+	}
+	if lang, ok := ctx.Value(localeContextKey{}).(Locale); ok && lang != "" {
+		return lang
+	}
+	return English
+}
+
+// lookupMessage renders the catalog entry for (locale, code) against params,
+// falling back to the English catalog if locale has no entry for code, and
+// returning ("", "") if no catalog has one either (the caller then falls
+// back to the ValidationError's own Message/Suggestion fields, which cover
+// rule-engine-sourced errors that have no catalog entry).
+func lookupMessage(locale Locale, code string, params map[string]any) (message, suggestion string) {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	tmpl, ok := catalogs[locale][code]
+	if !ok {
+		tmpl, ok = catalogs[English][code]
+		if !ok {
+			return "", ""
+		}
+	}
+
+	return renderTemplate(tmpl.Message, params), renderTemplate(tmpl.Suggestion, params)
+}
+
+// renderTemplate substitutes `{{param}}` placeholders with their values
+// from params, formatted with "%v".
+func renderTemplate(tmpl string, params map[string]any) string {
+	if tmpl == "" {
+		return ""
+	}
+	return catalogParamRegex.ReplaceAllStringFunc(tmpl, func(match string) string {
+		key := catalogParamRegex.FindStringSubmatch(match)[1]
+		if v, ok := params[key]; ok {
+			return fmt.Sprintf("%v", v)
+		}
+		return match
+	})
+}
+
+// defaultEnglishCatalog mirrors the inline Message/Suggestion strings used
+// by the built-in checks in constraints.go, keyed by their stable Code so
+// RegisterCatalog callers have a complete reference to translate from.
+func defaultEnglishCatalog() map[string]MessageTemplate {
+	return map[string]MessageTemplate{
+		CodeConstraintsNil: {
+			Message: "constraints cannot be nil",
+		},
+		CodeSvcNameRequired: {
+			Message: "service name is required",
+		},
+		CodeSvcNameLength: {
+			Message:    "service name must be 3-63 characters, got {{length}}",
+			Suggestion: "Use a concise, descriptive name like 'inventory-manager' or 'payment-processor'",
+		},
+		CodeSvcNameInvalid: {
+			Message: "service name must be lowercase letters, numbers, and hyphens only (DNS-compatible)",
+		},
+		CodeThroughputNonPositive: {
+			Message:    "throughput must be positive",
+			Suggestion: "Specify expected peak requests/messages per second (e.g., 1000 for typical API)",
+		},
+		CodeThroughputTooHigh: {
+			Message:    "throughput {{throughput_tps}} TPS exceeds reasonable maximum (1M TPS)",
+			Suggestion: "If you truly need >1M TPS, contact the OSE team for specialized architecture guidance",
+		},
+		CodeLatencyNonPositive: {
+			Message:    "latency target must be positive",
+			Suggestion: "Specify p99 latency target in milliseconds (e.g., 100 for typical API)",
+		},
+		CodeLatencyTooHigh: {
+			Message:    "latency target {{latency_p99_ms}}ms exceeds 60 seconds",
+			Suggestion: "For batch jobs with >60s latency, consider ServiceType = BACKGROUND_WORKER",
+		},
+		CodeServiceTypeUnspecified: {
+			Message:    "service type must be specified",
+			Suggestion: "Choose: API, EVENT_PROCESSOR, BACKGROUND_WORKER, or STREAM_PROCESSOR",
+		},
+		CodeConsistencyUnspecified: {
+			Message:    "consistency model must be specified",
+			Suggestion: "Choose STRONG (ACID transactions) or EVENTUAL (BASE properties)",
+		},
+		CodeDeploymentUnspecified: {
+			Message:    "deployment target must be specified",
+			Suggestion: "Choose: KUBERNETES, ECS, or LAMBDA",
+		},
+		CodeLatencyBelowStrongMin: {
+			Message:    "strong consistency requires minimum 50ms latency (ACID coordination overhead)",
+			Suggestion: "Either increase latency target to ≥50ms or use eventual consistency",
+		},
+		CodeLambdaThroughputTooHigh: {
+			Message:    "Lambda is not suitable for >10K TPS sustained throughput",
+			Suggestion: "Use KUBERNETES for high-throughput services",
+		},
+		CodeTooManyIntegrations: {
+			Message:    "service declares {{count}} integrations (max 10)",
+			Suggestion: "Services with >10 dependencies are likely violating single responsibility principle",
+		},
+		CodeIntegrationUnspecified: {
+			Message:    "integration type UNSPECIFIED is not allowed",
+			Suggestion: "Specify concrete integration types (KAFKA, POSTGRESQL, etc.)",
+		},
+	}
+}
@@ -3,6 +3,10 @@
 // This implements the "validation at the boundary" pattern where all defensive
 // programming is concentrated at the API Gateway, creating a trust boundary
 // between untrusted external inputs and trusted internal systems.
+//
+// Beyond the built-in structural/enum/numeric checks below, operators can
+// register additional cross-field policies at runtime without a redeploy
+// via the CEL-based rule Engine (rules.go) — see SetEngine.
 package validation
 
 import (
@@ -10,13 +14,104 @@ import (
 	"regexp"
 	"strings"
 
-	pb "github.com/your-org/ose-api/api/proto/advisory/v1"
+	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
 )
 
 // Service name must be DNS-compatible: lowercase, hyphens, 3-63 characters
 var serviceNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
 
-// ValidateServiceConstraints validates all aspects of service constraints.
+// defaultEngine is the process-wide CEL rule engine consulted by
+// ValidateServiceConstraints alongside the built-in checks (it runs whether
+// or not they pass, so one call reports every violation). It starts nil
+// (no extra rules) so existing callers are unaffected until an engine is
+// installed via SetEngine, typically at startup once rules have been loaded
+// from YAML/JSON or the UpdateValidationRules admin RPC.
+var defaultEngine *Engine
+
+// SetEngine installs the rule engine consulted by ValidateServiceConstraints.
+// Passing nil disables rule-based validation, leaving only the built-in
+// checks.
+func SetEngine(e *Engine) {
+	defaultEngine = e
+}
+
+// DefaultEngine returns the process-wide rule engine installed via
+// SetEngine, or nil if none has been installed. Callers that need scoped
+// enforcement (see Policy, EvaluateWithPolicy) use this to reach the same
+// rule set ValidateServiceConstraints consults.
+func DefaultEngine() *Engine {
+	return defaultEngine
+}
+
+// Stable error codes, used as catalog keys (see catalog.go) and as the
+// machine-readable identifier JSON/API consumers key off of.
+const (
+	CodeConstraintsNil          = "CONSTRAINTS_NIL"
+	CodeSvcNameRequired         = "SVC_NAME_REQUIRED"
+	CodeSvcNameLength           = "SVC_NAME_LENGTH"
+	CodeSvcNameInvalid          = "SVC_NAME_INVALID"
+	CodeThroughputNonPositive   = "THROUGHPUT_NON_POSITIVE"
+	CodeThroughputTooHigh       = "THROUGHPUT_TOO_HIGH"
+	CodeLatencyNonPositive      = "LATENCY_NON_POSITIVE"
+	CodeLatencyTooHigh          = "LATENCY_TOO_HIGH"
+	CodeServiceTypeUnspecified  = "SERVICE_TYPE_UNSPECIFIED"
+	CodeConsistencyUnspecified  = "CONSISTENCY_MODEL_UNSPECIFIED"
+	CodeDeploymentUnspecified   = "DEPLOYMENT_TARGET_UNSPECIFIED"
+	CodeLatencyBelowStrongMin   = "LATENCY_BELOW_STRONG_MIN"
+	CodeLambdaThroughputTooHigh = "LAMBDA_THROUGHPUT_TOO_HIGH"
+	CodeTooManyIntegrations     = "TOO_MANY_INTEGRATIONS"
+	CodeIntegrationUnspecified  = "INTEGRATION_TYPE_UNSPECIFIED"
+)
+
+// ValidateServiceConstraints validates c against the built-in checks (see
+// ValidateServiceConstraintsStructural) plus the operator-defined CEL rules
+// at their default enforcement (ERROR severity denies, WARN severity never
+// fails the call). Callers that need scoped DENY/WARN/DRYRUN enforcement —
+// anything consulting a per-request or per-tenant Policy — must call
+// ValidateServiceConstraintsWithPolicy instead, or this function's built-in
+// default scoping will already have rejected the request before the
+// caller's Policy is ever consulted.
+func ValidateServiceConstraints(c *pb.ServiceConstraints) error {
+	return ValidateServiceConstraintsWithPolicy(c, Policy{})
+}
+
+// ValidateServiceConstraintsWithPolicy validates c against the built-in
+// checks (ValidateServiceConstraintsStructural) plus the operator-defined
+// CEL rules (rules.go), scoped by policy: only rules policy resolves to
+// ScopeDeny contribute to the returned error; ScopeWarn/ScopeDryRun rules
+// never do, regardless of their Severity. Callers that also want the
+// WARN/DRYRUN outcomes (not just this pass/fail result) should call
+// DefaultEngine().EvaluateWithPolicy(c, policy) themselves — see
+// AdvisoryHandler.ValidateService — rather than inferring them from this
+// function's error, and should use ValidateServiceConstraintsStructural
+// instead of this function to avoid evaluating the rule engine twice.
+//
+// Returns:
+//   nil if all constraints are valid
+//   *ValidationReport describing every violation encountered
+func ValidateServiceConstraintsWithPolicy(c *pb.ServiceConstraints, policy Policy) error {
+	report := structuralReport(c)
+
+	if defaultEngine != nil {
+		for _, outcome := range defaultEngine.EvaluateWithPolicy(c, policy) {
+			if outcome.Passed || outcome.Scope != ScopeDeny {
+				continue
+			}
+			report.add("RULE_"+outcome.RuleID, outcome.FieldPath, map[string]any{"rule_id": outcome.RuleID}, outcome.Message, "")
+		}
+	}
+
+	if len(report.Errors) == 0 {
+		return nil
+	}
+	return report
+}
+
+// ValidateServiceConstraintsStructural validates c against only the
+// built-in invariants below — no operator-defined CEL rules — so a caller
+// that separately consults DefaultEngine().EvaluateWithPolicy for scoped
+// rule outcomes (see AdvisoryHandler.ValidateService) doesn't evaluate the
+// same rules twice through two code paths that could disagree about scope.
 //
 // This function implements the mathematical invariants specified in the
 // architectural documentation:
@@ -27,43 +122,56 @@ var serviceNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
 //   4. Cross-Field Constraints (interdependent validation rules)
 //   5. Integration Constraints (valid integration combinations)
 //
+// Unlike a fail-fast validator, every check below always runs so a single
+// round-trip can surface every problem at once; the results are collected
+// into a *ValidationReport (report.go) rather than returned as the first
+// error encountered.
+//
 // Returns:
 //   nil if all constraints are valid
-//   ValidationError describing the first violation encountered
-func ValidateServiceConstraints(c *pb.ServiceConstraints) error {
+//   *ValidationReport describing every violation encountered
+func ValidateServiceConstraintsStructural(c *pb.ServiceConstraints) error {
+	report := structuralReport(c)
+	if len(report.Errors) == 0 {
+		return nil
+	}
+	return report
+}
+
+// structuralReport runs invariants 1-5 (not the CEL rule engine) and
+// returns the resulting report, never nil, so callers can keep appending to
+// it (ValidateServiceConstraintsWithPolicy) or return it directly
+// (ValidateServiceConstraintsStructural).
+func structuralReport(c *pb.ServiceConstraints) *ValidationReport {
 	if c == nil {
-		return &ValidationError{
+		return &ValidationReport{Errors: []*ValidationError{{
+			Code:    CodeConstraintsNil,
 			Field:   "constraints",
+			Params:  map[string]any{},
 			Message: "constraints cannot be nil",
-		}
+		}}}
 	}
 
+	report := &ValidationReport{}
+
 	// ═══════════════════════════════════════════════════════════════════════
 	// INVARIANT 1: STRUCTURAL VALIDITY
 	// ═══════════════════════════════════════════════════════════════════════
 
-	// Service name validation
-	if c.ServiceName == "" {
-		return &ValidationError{
-			Field:   "service_name",
-			Message: "service name is required",
-		}
-	}
-
-	if len(c.ServiceName) < 3 || len(c.ServiceName) > 63 {
-		return &ValidationError{
-			Field:   "service_name",
-			Message: fmt.Sprintf("service name must be 3-63 characters, got %d", len(c.ServiceName)),
-			Suggestion: "Use a concise, descriptive name like 'inventory-manager' or 'payment-processor'",
-		}
-	}
-
-	if !serviceNameRegex.MatchString(c.ServiceName) {
-		return &ValidationError{
-			Field:   "service_name",
-			Message: "service name must be lowercase letters, numbers, and hyphens only (DNS-compatible)",
-			Suggestion: fmt.Sprintf("Try: %s", strings.ToLower(strings.ReplaceAll(c.ServiceName, "_", "-"))),
-		}
+	switch {
+	case c.ServiceName == "":
+		report.add(CodeSvcNameRequired, "service_name", nil,
+			"service name is required", "")
+	case len(c.ServiceName) < 3 || len(c.ServiceName) > 63:
+		report.add(CodeSvcNameLength, "service_name",
+			map[string]any{"length": len(c.ServiceName)},
+			fmt.Sprintf("service name must be 3-63 characters, got %d", len(c.ServiceName)),
+			"Use a concise, descriptive name like 'inventory-manager' or 'payment-processor'")
+	case !serviceNameRegex.MatchString(c.ServiceName):
+		report.add(CodeSvcNameInvalid, "service_name",
+			map[string]any{"service_name": c.ServiceName},
+			"service name must be lowercase letters, numbers, and hyphens only (DNS-compatible)",
+			fmt.Sprintf("Try: %s", strings.ToLower(strings.ReplaceAll(c.ServiceName, "_", "-"))))
 	}
 
 	// ═══════════════════════════════════════════════════════════════════════
@@ -72,36 +180,26 @@ func ValidateServiceConstraints(c *pb.ServiceConstraints) error {
 
 	// Throughput validation
 	if c.ThroughputTps <= 0 {
-		return &ValidationError{
-			Field:   "throughput_tps",
-			Message: "throughput must be positive",
-			Suggestion: "Specify expected peak requests/messages per second (e.g., 1000 for typical API)",
-		}
-	}
-
-	if c.ThroughputTps > 1_000_000 {
-		return &ValidationError{
-			Field:   "throughput_tps",
-			Message: fmt.Sprintf("throughput %d TPS exceeds reasonable maximum (1M TPS)", c.ThroughputTps),
-			Suggestion: "If you truly need >1M TPS, contact the OSE team for specialized architecture guidance",
-		}
+		report.add(CodeThroughputNonPositive, "throughput_tps", nil,
+			"throughput must be positive",
+			"Specify expected peak requests/messages per second (e.g., 1000 for typical API)")
+	} else if c.ThroughputTps > 1_000_000 {
+		report.add(CodeThroughputTooHigh, "throughput_tps",
+			map[string]any{"throughput_tps": c.ThroughputTps},
+			fmt.Sprintf("throughput %d TPS exceeds reasonable maximum (1M TPS)", c.ThroughputTps),
+			"If you truly need >1M TPS, contact the OSE team for specialized architecture guidance")
 	}
 
 	// Latency validation
 	if c.LatencyP99Ms <= 0 {
-		return &ValidationError{
-			Field:   "latency_p99_ms",
-			Message: "latency target must be positive",
-			Suggestion: "Specify p99 latency target in milliseconds (e.g., 100 for typical API)",
-		}
-	}
-
-	if c.LatencyP99Ms > 60_000 {
-		return &ValidationError{
-			Field:   "latency_p99_ms",
-			Message: fmt.Sprintf("latency target %dms exceeds 60 seconds", c.LatencyP99Ms),
-			Suggestion: "For batch jobs with >60s latency, consider ServiceType = BACKGROUND_WORKER",
-		}
+		report.add(CodeLatencyNonPositive, "latency_p99_ms", nil,
+			"latency target must be positive",
+			"Specify p99 latency target in milliseconds (e.g., 100 for typical API)")
+	} else if c.LatencyP99Ms > 60_000 {
+		report.add(CodeLatencyTooHigh, "latency_p99_ms",
+			map[string]any{"latency_p99_ms": c.LatencyP99Ms},
+			fmt.Sprintf("latency target %dms exceeds 60 seconds", c.LatencyP99Ms),
+			"For batch jobs with >60s latency, consider ServiceType = BACKGROUND_WORKER")
 	}
 
 	// ═══════════════════════════════════════════════════════════════════════
@@ -110,29 +208,23 @@ func ValidateServiceConstraints(c *pb.ServiceConstraints) error {
 
 	// Service type validation
 	if c.ServiceType == pb.ServiceType_SERVICE_TYPE_UNSPECIFIED {
-		return &ValidationError{
-			Field:   "service_type",
-			Message: "service type must be specified",
-			Suggestion: "Choose: API, EVENT_PROCESSOR, BACKGROUND_WORKER, or STREAM_PROCESSOR",
-		}
+		report.add(CodeServiceTypeUnspecified, "service_type", nil,
+			"service type must be specified",
+			"Choose: API, EVENT_PROCESSOR, BACKGROUND_WORKER, or STREAM_PROCESSOR")
 	}
 
 	// Consistency model validation
 	if c.ConsistencyModel == pb.ConsistencyModel_CONSISTENCY_MODEL_UNSPECIFIED {
-		return &ValidationError{
-			Field:   "consistency_model",
-			Message: "consistency model must be specified",
-			Suggestion: "Choose STRONG (ACID transactions) or EVENTUAL (BASE properties)",
-		}
+		report.add(CodeConsistencyUnspecified, "consistency_model", nil,
+			"consistency model must be specified",
+			"Choose STRONG (ACID transactions) or EVENTUAL (BASE properties)")
 	}
 
 	// Deployment target validation
 	if c.DeploymentTarget == pb.DeploymentTarget_DEPLOYMENT_TARGET_UNSPECIFIED {
-		return &ValidationError{
-			Field:   "deployment_target",
-			Message: "deployment target must be specified",
-			Suggestion: "Choose: KUBERNETES, ECS, or LAMBDA",
-		}
+		report.add(CodeDeploymentUnspecified, "deployment_target", nil,
+			"deployment target must be specified",
+			"Choose: KUBERNETES, ECS, or LAMBDA")
 	}
 
 	// ═══════════════════════════════════════════════════════════════════════
@@ -141,20 +233,18 @@ func ValidateServiceConstraints(c *pb.ServiceConstraints) error {
 
 	// Strong consistency implies minimum latency overhead
 	if c.ConsistencyModel == pb.ConsistencyModel_CONSISTENCY_MODEL_STRONG && c.LatencyP99Ms < 50 {
-		return &ValidationError{
-			Field:   "latency_p99_ms",
-			Message: "strong consistency requires minimum 50ms latency (ACID coordination overhead)",
-			Suggestion: "Either increase latency target to ≥50ms or use eventual consistency",
-		}
+		report.add(CodeLatencyBelowStrongMin, "latency_p99_ms",
+			map[string]any{"latency_p99_ms": c.LatencyP99Ms},
+			"strong consistency requires minimum 50ms latency (ACID coordination overhead)",
+			"Either increase latency target to ≥50ms or use eventual consistency")
 	}
 
 	// High throughput requires appropriate deployment target
 	if c.ThroughputTps > 10_000 && c.DeploymentTarget == pb.DeploymentTarget_DEPLOYMENT_TARGET_LAMBDA {
-		return &ValidationError{
-			Field:   "deployment_target",
-			Message: "Lambda is not suitable for >10K TPS sustained throughput",
-			Suggestion: "Use KUBERNETES for high-throughput services",
-		}
+		report.add(CodeLambdaThroughputTooHigh, "deployment_target",
+			map[string]any{"throughput_tps": c.ThroughputTps},
+			"Lambda is not suitable for >10K TPS sustained throughput",
+			"Use KUBERNETES for high-throughput services")
 	}
 
 	// ═══════════════════════════════════════════════════════════════════════
@@ -162,39 +252,21 @@ func ValidateServiceConstraints(c *pb.ServiceConstraints) error {
 	// ═══════════════════════════════════════════════════════════════════════
 
 	if len(c.Integrations) > 10 {
-		return &ValidationError{
-			Field:   "integrations",
-			Message: fmt.Sprintf("service declares %d integrations (max 10)", len(c.Integrations)),
-			Suggestion: "Services with >10 dependencies are likely violating single responsibility principle",
-		}
+		report.add(CodeTooManyIntegrations, "integrations",
+			map[string]any{"count": len(c.Integrations)},
+			fmt.Sprintf("service declares %d integrations (max 10)", len(c.Integrations)),
+			"Services with >10 dependencies are likely violating single responsibility principle")
 	}
 
 	// Validate each integration type is valid
 	for _, integration := range c.Integrations {
 		if integration == pb.IntegrationType_INTEGRATION_TYPE_UNSPECIFIED {
-			return &ValidationError{
-				Field:   "integrations",
-				Message: "integration type UNSPECIFIED is not allowed",
-				Suggestion: "Specify concrete integration types (KAFKA, POSTGRESQL, etc.)",
-			}
+			report.add(CodeIntegrationUnspecified, "integrations", nil,
+				"integration type UNSPECIFIED is not allowed",
+				"Specify concrete integration types (KAFKA, POSTGRESQL, etc.)")
+			break
 		}
 	}
 
-	// All validations passed
-	return nil
-}
-
-// ValidationError represents a constraint validation failure with context.
-type ValidationError struct {
-	Field      string // Which field failed validation
-	Message    string // What went wrong
-	Suggestion string // How to fix it (constructive validation)
-}
-
-// Error implements the error interface.
-func (e *ValidationError) Error() string {
-	if e.Suggestion != "" {
-		return fmt.Sprintf("%s: %s. Suggestion: %s", e.Field, e.Message, e.Suggestion)
-	}
-	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	return report
 }
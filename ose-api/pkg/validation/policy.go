@@ -0,0 +1,101 @@
+package validation
+
+import (
+	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+)
+
+// EnforcementScope controls how a failing rule affects the caller: DENY
+// rejects the request, WARN surfaces the failure without rejecting it, and
+// DRYRUN neither rejects nor warns: GenerateBlueprint only logs the failure,
+// and ValidateService lists it in its report under its scope. This lets a
+// team roll out a
+// new cross-field rule by shipping it as DRYRUN, watching its hit rate,
+// promoting it to WARN, and only then to DENY — without ever redeploying
+// the rule itself.
+type EnforcementScope string
+
+const (
+	ScopeDeny   EnforcementScope = "DENY"
+	ScopeWarn   EnforcementScope = "WARN"
+	ScopeDryRun EnforcementScope = "DRYRUN"
+)
+
+// Policy assigns an EnforcementScope to rule-engine rules by ID, with a
+// Default applied to any rule not named in Overrides. An empty Default
+// falls back to the rule's own Severity (ERROR -> DENY, WARN -> WARN),
+// preserving today's behavior for callers that never set a policy.
+type Policy struct {
+	Default   EnforcementScope
+	Overrides map[string]EnforcementScope
+}
+
+// scopeFor resolves the effective scope for a compiled rule under p.
+func (p Policy) scopeFor(r *compiledRule) EnforcementScope {
+	if p.Overrides != nil {
+		if scope, ok := p.Overrides[r.ID]; ok {
+			return scope
+		}
+	}
+	if p.Default != "" {
+		return p.Default
+	}
+	if r.Severity == SeverityWarn {
+		return ScopeWarn
+	}
+	return ScopeDeny
+}
+
+// RuleOutcome is the per-rule result of evaluating one rule-engine rule
+// under a Policy: whether it passed, the scope it was evaluated at, and
+// (when it failed) the rendered message. A Rule names no field of its own,
+// so FieldPath carries the rule's ID.
+type RuleOutcome struct {
+	RuleID    string           `json:"rule_id"`
+	Scope     EnforcementScope `json:"scope"`
+	Passed    bool             `json:"passed"`
+	Message   string           `json:"message,omitempty"`
+	FieldPath string           `json:"field_path,omitempty"`
+}
+
+// EvaluateWithPolicy runs every loaded rule against c and reports the full
+// per-rule outcome — including rules that passed — so a caller can
+// distinguish "this DENY rule passed" from "no DENY rules are configured".
+// It's the basis for
+// ValidateService's structured report and for GenerateBlueprint's
+// scoped enforcement (DENY rejects, WARN is attached as a blueprint
+// warning, DRYRUN is logged only).
+func (e *Engine) EvaluateWithPolicy(c *pb.ServiceConstraints, policy Policy) []RuleOutcome {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+
+	outcomes := make([]RuleOutcome, 0, len(rules))
+	for _, r := range rules {
+		e.evaluations.WithLabelValues(r.ID).Inc()
+		scope := policy.scopeFor(r)
+
+		violated, err := r.evaluate(c)
+		if err != nil {
+			// Unevaluable (e.g. optional field absent): treat as passed,
+			// matching Evaluate's behavior.
+			outcomes = append(outcomes, RuleOutcome{RuleID: r.ID, Scope: scope, Passed: true})
+			continue
+		}
+
+		if !violated {
+			outcomes = append(outcomes, RuleOutcome{RuleID: r.ID, Scope: scope, Passed: true})
+			continue
+		}
+
+		e.violations.WithLabelValues(r.ID, string(r.Severity)).Inc()
+		outcomes = append(outcomes, RuleOutcome{
+			RuleID:    r.ID,
+			Scope:     scope,
+			Passed:    false,
+			Message:   r.render(r.messageExprs, r.MessageTemplate, c),
+			FieldPath: r.ID,
+		})
+	}
+
+	return outcomes
+}
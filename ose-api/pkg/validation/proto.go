@@ -0,0 +1,22 @@
+package validation
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// AsProto converts the report into a google.rpc.BadRequest message, letting
+// the gRPC server attach it as error detail via status.WithDetails so
+// grpc-gateway and grpc-web clients can render `field_violations` using
+// their standard tooling instead of parsing Error()'s string form.
+func (r *ValidationReport) AsProto(ctx context.Context) *errdetails.BadRequest {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(r.Errors))
+	for _, ve := range r.Errors {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       ve.Field,
+			Description: ve.Render(ctx),
+		})
+	}
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
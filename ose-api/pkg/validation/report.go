@@ -0,0 +1,124 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// ValidationError represents a single constraint validation failure.
+//
+// Code is a stable, machine-readable identifier (e.g. "SVC_NAME_INVALID")
+// suitable for dashboards and client-side switch statements that must not
+// break when the human-readable Message wording changes. Params carries the
+// values substituted into the catalog message/suggestion templates (see
+// catalog.go) so callers needing structured data (a UI highlighting the
+// offending number) don't have to parse Message.
+type ValidationError struct {
+	Code       string         `json:"code"`
+	Field      string         `json:"field"`
+	Params     map[string]any `json:"params,omitempty"`
+	Severity   Severity       `json:"severity"`
+	Message    string         `json:"-"` // English fallback; Error()/Render() prefer the catalog
+	Suggestion string         `json:"-"`
+}
+
+// Error implements the error interface, rendering in the default (English)
+// locale. Use Render for locale-aware rendering.
+func (e *ValidationError) Error() string {
+	return e.Render(context.Background())
+}
+
+// Render formats the error using the catalog registered for the locale
+// found in ctx (see WithLocale), falling back to English if ctx carries no
+// locale or the locale has no entry for Code.
+func (e *ValidationError) Render(ctx context.Context) string {
+	msg, suggestion := lookupMessage(localeFromContext(ctx), e.Code, e.Params)
+	if msg == "" {
+		msg = e.Message
+	}
+	if suggestion == "" {
+		suggestion = e.Suggestion
+	}
+
+	if suggestion != "" {
+		return e.Field + ": " + msg + ". Suggestion: " + suggestion
+	}
+	return e.Field + ": " + msg
+}
+
+// MarshalJSON renders the `{code, field, params, severity, suggestion}`
+// shape API consumers expect, independent of the Go-only Message/Suggestion
+// fallback fields.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	severity := e.Severity
+	if severity == "" {
+		severity = SeverityError
+	}
+	_, suggestion := lookupMessage(English, e.Code, e.Params)
+	if suggestion == "" {
+		suggestion = e.Suggestion
+	}
+
+	type wire struct {
+		Code       string         `json:"code"`
+		Field      string         `json:"field"`
+		Params     map[string]any `json:"params,omitempty"`
+		Severity   Severity       `json:"severity"`
+		Suggestion string         `json:"suggestion,omitempty"`
+	}
+	return json.Marshal(wire{
+		Code:       e.Code,
+		Field:      e.Field,
+		Params:     e.Params,
+		Severity:   severity,
+		Suggestion: suggestion,
+	})
+}
+
+// ValidationReport aggregates every check performed by
+// ValidateServiceConstraints, whether built-in or rule-engine sourced, so a
+// UI or CLI can display every problem from a single round-trip rather than
+// fixing issues one at a time.
+type ValidationReport struct {
+	Errors []*ValidationError `json:"errors"`
+}
+
+// add appends a built-in-check failure to the report. A nil suggestion
+// argument of "" omits the suggestion.
+func (r *ValidationReport) add(code, field string, params map[string]any, message, suggestion string) {
+	if params == nil {
+		params = map[string]any{}
+	}
+	r.Errors = append(r.Errors, &ValidationError{
+		Code:       code,
+		Field:      field,
+		Params:     params,
+		Severity:   SeverityError,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+// Error implements the error interface, joining every contained error.
+func (r *ValidationReport) Error() string {
+	msgs := make([]string, len(r.Errors))
+	for i, ve := range r.Errors {
+		msgs[i] = ve.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap supports errors.Is/As over the contained ValidationErrors.
+func (r *ValidationReport) Unwrap() []error {
+	errs := make([]error, len(r.Errors))
+	for i, ve := range r.Errors {
+		errs[i] = ve
+	}
+	return errs
+}
+
+// ValidationErrors is a deprecated alias for ValidationReport, kept so code
+// written against the earlier aggregate-only shape (pre-i18n) still
+// compiles; new code should use ValidationReport directly.
+type ValidationErrors = ValidationReport
@@ -0,0 +1,308 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/prometheus/client_golang/prometheus"
+
+	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+)
+
+// Severity controls how a rule failure is treated by ValidateServiceConstraints.
+type Severity string
+
+const (
+	SeverityError Severity = "ERROR"
+	SeverityWarn  Severity = "WARN"
+)
+
+// Rule is a single user-supplied cross-field policy, evaluated against a
+// `constraints` variable bound to the incoming pb.ServiceConstraints.
+//
+// MessageTemplate and SuggestionTemplate may reference CEL sub-expressions
+// via `${...}` placeholders, e.g. "throughput ${constraints.throughput_tps}
+// exceeds the team budget". Placeholders are evaluated independently of the
+// rule's own Expression and interpolated into the rendered string.
+type Rule struct {
+	ID                 string   `yaml:"id" json:"id"`
+	Expression         string   `yaml:"expression" json:"expression"`
+	Severity           Severity `yaml:"severity" json:"severity"`
+	MessageTemplate    string   `yaml:"message" json:"message"`
+	SuggestionTemplate string   `yaml:"suggestion,omitempty" json:"suggestion,omitempty"`
+}
+
+// compiledRule pairs a Rule with its pre-compiled CEL program so evaluation
+// at request time never pays compilation cost.
+type compiledRule struct {
+	Rule
+	program      cel.Program
+	messageExprs []placeholder
+	suggestExprs []placeholder
+}
+
+// placeholder is one `${...}` CEL sub-expression found in a template string,
+// along with its compiled program.
+type placeholder struct {
+	expr    string
+	program cel.Program
+}
+
+var placeholderRegex = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// Engine holds a compiled, hot-swappable set of validation rules plus the
+// CEL environment they were compiled against and per-rule Prometheus
+// counters.
+//
+// Engine is safe for concurrent use: rule sets are swapped atomically via a
+// copy-on-write pointer so in-flight evaluations never observe a half-
+// updated rule set.
+type Engine struct {
+	env *cel.Env
+
+	mu    sync.RWMutex
+	rules []*compiledRule
+
+	evaluations *prometheus.CounterVec
+	violations  *prometheus.CounterVec
+}
+
+// NewEngine constructs an Engine with a CEL environment that exposes a
+// single `constraints` variable of type pb.ServiceConstraints, and
+// registers its per-rule counters with reg.
+func NewEngine(reg prometheus.Registerer) (*Engine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("constraints", cel.ObjectType("advisory.v1.ServiceConstraints")),
+		cel.Types(&pb.ServiceConstraints{}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("validation: building CEL environment: %w", err)
+	}
+
+	e := &Engine{
+		env: env,
+		evaluations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "ose",
+			Subsystem: "validation",
+			Name:      "rule_evaluations_total",
+			Help:      "Number of times a validation rule was evaluated.",
+		}, []string{"rule_id"}),
+		violations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "ose",
+			Subsystem: "validation",
+			Name:      "rule_violations_total",
+			Help:      "Number of times a validation rule was violated.",
+		}, []string{"rule_id", "severity"}),
+	}
+
+	if reg != nil {
+		if err := reg.Register(e.evaluations); err != nil {
+			return nil, fmt.Errorf("validation: registering rule_evaluations_total: %w", err)
+		}
+		if err := reg.Register(e.violations); err != nil {
+			return nil, fmt.Errorf("validation: registering rule_violations_total: %w", err)
+		}
+	}
+
+	return e, nil
+}
+
+// LoadRules compiles rules and, if every rule compiles successfully, swaps
+// them in atomically. A compile failure in any rule rejects the whole batch
+// so operators never end up with a partially-applied policy set.
+func (e *Engine) LoadRules(rules []Rule) error {
+	compiled := make([]*compiledRule, 0, len(rules))
+
+	for _, r := range rules {
+		cr, err := e.compile(r)
+		if err != nil {
+			return fmt.Errorf("validation: compiling rule %q: %w", r.ID, err)
+		}
+		compiled = append(compiled, cr)
+	}
+
+	e.mu.Lock()
+	e.rules = compiled
+	e.mu.Unlock()
+
+	return nil
+}
+
+// DryRun evaluates a candidate rule set against a batch of previously
+// accepted constraints without promoting it, returning the rules that would
+// newly fail so operators can review impact before calling LoadRules.
+func (e *Engine) DryRun(rules []Rule, sample []*pb.ServiceConstraints) (map[string][]string, error) {
+	compiled := make([]*compiledRule, 0, len(rules))
+	for _, r := range rules {
+		cr, err := e.compile(r)
+		if err != nil {
+			return nil, fmt.Errorf("validation: compiling rule %q: %w", r.ID, err)
+		}
+		compiled = append(compiled, cr)
+	}
+
+	failures := make(map[string][]string)
+	for _, c := range sample {
+		for _, cr := range compiled {
+			if cr.Severity != SeverityError {
+				continue
+			}
+			violated, err := cr.evaluate(c)
+			if err != nil {
+				continue // unevaluable sample, skip rather than fail the whole dry run
+			}
+			if violated {
+				failures[cr.ID] = append(failures[cr.ID], c.GetServiceName())
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+// Evaluate runs every loaded rule against c and returns the ValidationErrors
+// produced by ERROR-severity rules that fail. WARN-severity failures are
+// returned alongside as a second slice so callers can surface them without
+// treating them as hard failures.
+func (e *Engine) Evaluate(c *pb.ServiceConstraints) (errs []*ValidationError, warnings []*ValidationError) {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+
+	for _, r := range rules {
+		e.evaluations.WithLabelValues(r.ID).Inc()
+
+		violated, err := r.evaluate(c)
+		if err != nil {
+			// A rule that fails to evaluate (e.g. type mismatch on an
+			// optional field) is treated as non-violating rather than
+			// aborting the whole request.
+			continue
+		}
+		if !violated {
+			continue
+		}
+
+		e.violations.WithLabelValues(r.ID, string(r.Severity)).Inc()
+
+		ve := &ValidationError{
+			Code:       "RULE_" + r.ID,
+			Field:      r.ID,
+			Params:     map[string]any{"rule_id": r.ID},
+			Severity:   r.Severity,
+			Message:    r.render(r.messageExprs, r.MessageTemplate, c),
+			Suggestion: r.render(r.suggestExprs, r.SuggestionTemplate, c),
+		}
+
+		if r.Severity == SeverityError {
+			errs = append(errs, ve)
+		} else {
+			warnings = append(warnings, ve)
+		}
+	}
+
+	return errs, warnings
+}
+
+// compile pre-compiles a rule's CEL expression and any `${...}` placeholders
+// in its message/suggestion templates.
+func (e *Engine) compile(r Rule) (*compiledRule, error) {
+	if r.Severity == "" {
+		r.Severity = SeverityError
+	}
+
+	ast, iss := e.env.Compile(r.Expression)
+	if iss != nil && iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	program, err := e.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	msgExprs, err := e.compilePlaceholders(r.MessageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("message template: %w", err)
+	}
+	sugExprs, err := e.compilePlaceholders(r.SuggestionTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("suggestion template: %w", err)
+	}
+
+	return &compiledRule{
+		Rule:         r,
+		program:      program,
+		messageExprs: msgExprs,
+		suggestExprs: sugExprs,
+	}, nil
+}
+
+// compilePlaceholders compiles every `${...}` sub-expression found in tmpl.
+func (e *Engine) compilePlaceholders(tmpl string) ([]placeholder, error) {
+	matches := placeholderRegex.FindAllStringSubmatch(tmpl, -1)
+	if matches == nil {
+		return nil, nil
+	}
+
+	placeholders := make([]placeholder, 0, len(matches))
+	for _, m := range matches {
+		expr := m[1]
+		ast, iss := e.env.Compile(expr)
+		if iss != nil && iss.Err() != nil {
+			return nil, fmt.Errorf("placeholder %q: %w", expr, iss.Err())
+		}
+		program, err := e.env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("placeholder %q: %w", expr, err)
+		}
+		placeholders = append(placeholders, placeholder{expr: expr, program: program})
+	}
+
+	return placeholders, nil
+}
+
+// evaluate runs the rule's compiled program against c and returns whether it
+// was violated (the CEL expression is written as the *pass* condition, so a
+// `false` result means the rule fired).
+func (r *compiledRule) evaluate(c *pb.ServiceConstraints) (bool, error) {
+	out, _, err := r.program.Eval(map[string]interface{}{"constraints": c})
+	if err != nil {
+		return false, err
+	}
+	passed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %q did not evaluate to a bool", r.ID)
+	}
+	return !passed, nil
+}
+
+// render substitutes each compiled placeholder's evaluated value back into
+// tmpl in source order.
+func (r *compiledRule) render(exprs []placeholder, tmpl string, c *pb.ServiceConstraints) string {
+	if tmpl == "" || len(exprs) == 0 {
+		return tmpl
+	}
+
+	i := 0
+	return placeholderRegex.ReplaceAllStringFunc(tmpl, func(string) string {
+		if i >= len(exprs) {
+			return ""
+		}
+		p := exprs[i]
+		i++
+
+		out, _, err := p.program.Eval(map[string]interface{}{"constraints": c})
+		if err != nil {
+			return fmt.Sprintf("<error: %v>", err)
+		}
+		return formatCELValue(out)
+	})
+}
+
+// formatCELValue renders a CEL evaluation result for template interpolation.
+func formatCELValue(v ref.Val) string {
+	return fmt.Sprintf("%v", v.Value())
+}
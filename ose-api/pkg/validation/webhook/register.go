@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WebhookConfigName is the name used for the self-registered
+// ValidatingWebhookConfiguration object.
+const WebhookConfigName = "ose-service-constraints.ose.io"
+
+// RegistrationOptions describes the ValidatingWebhookConfiguration to
+// self-register on startup.
+type RegistrationOptions struct {
+	// ServiceName/ServiceNamespace/ServicePort identify the in-cluster
+	// Service fronting this webhook's TLS listener.
+	ServiceName      string
+	ServiceNamespace string
+	ServicePort      int32
+
+	// CABundle is the PEM-encoded CA certificate the API server should use
+	// to verify this webhook's TLS certificate.
+	CABundle []byte
+}
+
+// Register creates or updates the ValidatingWebhookConfiguration so the API
+// server starts calling this webhook for ServiceConstraints CRD writes,
+// using client to talk to the cluster's API server.
+func Register(ctx context.Context, client kubernetes.Interface, opts RegistrationOptions) error {
+	path := "/validate/service-constraints"
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	failurePolicy := admissionregistrationv1.Fail
+
+	cfg := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: WebhookConfigName},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name: WebhookConfigName,
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Name:      opts.ServiceName,
+						Namespace: opts.ServiceNamespace,
+						Path:      &path,
+						Port:      &opts.ServicePort,
+					},
+					CABundle: opts.CABundle,
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create,
+							admissionregistrationv1.Update,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"ose.io"},
+							APIVersions: []string{"v1"},
+							Resources:   []string{"serviceconstraints"},
+						},
+					},
+				},
+				SideEffects:             &sideEffects,
+				FailurePolicy:           &failurePolicy,
+				AdmissionReviewVersions: []string{"v1"},
+			},
+		},
+	}
+
+	webhooks := client.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+
+	existing, err := webhooks.Get(ctx, WebhookConfigName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err := webhooks.Create(ctx, cfg, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("webhook: creating ValidatingWebhookConfiguration: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("webhook: fetching existing ValidatingWebhookConfiguration: %w", err)
+	}
+
+	cfg.ResourceVersion = existing.ResourceVersion
+	if _, err := webhooks.Update(ctx, cfg, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("webhook: updating ValidatingWebhookConfiguration: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// certWatchDebounce mirrors config.watchDebounce: cert-manager and kubelet
+// secret mounts rewrite both files via a symlink swap, which fsnotify
+// reports as a burst of events per rotation.
+const certWatchDebounce = 500 * time.Millisecond
+
+// Server hosts the admission webhook Handler behind TLS, reloading the
+// certificate/key pair in place whenever the underlying files change so
+// cert-manager rotations don't require a pod restart.
+type Server struct {
+	addr     string
+	certFile string
+	keyFile  string
+	logger   *zap.Logger
+	handler  http.Handler
+
+	cert atomic.Pointer[tls.Certificate]
+}
+
+// NewServer constructs a Server that will serve handler over TLS at addr,
+// using certFile/keyFile for the initial certificate.
+func NewServer(addr, certFile, keyFile string, handler http.Handler, logger *zap.Logger) (*Server, error) {
+	s := &Server{
+		addr:     addr,
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger,
+		handler:  handler,
+	}
+
+	if err := s.reloadCert(); err != nil {
+		return nil, fmt.Errorf("webhook: loading initial certificate: %w", err)
+	}
+
+	return s, nil
+}
+
+// ListenAndServeTLS starts the HTTPS listener, serving until ctx is
+// cancelled. It runs the cert-rotation watcher in the background for the
+// lifetime of the call.
+func (s *Server) ListenAndServeTLS(ctx context.Context) error {
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+
+	go func() {
+		if err := s.watchCert(watchCtx); err != nil && watchCtx.Err() == nil {
+			s.logger.Error("webhook: certificate watcher stopped", zap.Error(err))
+		}
+	}()
+
+	srv := &http.Server{
+		Addr:    s.addr,
+		Handler: s.handler,
+		TLSConfig: &tls.Config{
+			GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+				return s.cert.Load(), nil
+			},
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServeTLS("", "")
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	}
+}
+
+// reloadCert reads the current cert/key files and atomically swaps them
+// into the in-flight TLS config.
+func (s *Server) reloadCert() error {
+	cert, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+	if err != nil {
+		return err
+	}
+	s.cert.Store(&cert)
+	return nil
+}
+
+// watchCert mirrors config.Watch's debounce-then-reload shape, scoped to
+// the two certificate files instead of a config YAML.
+func (s *Server) watchCert(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	for _, path := range []string{s.certFile, s.keyFile} {
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("watching %s: %w", path, err)
+		}
+	}
+
+	var debounce *time.Timer
+	reload := make(chan struct{}, 1)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if debounce != nil {
+				debounce.Stop()
+			}
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return fmt.Errorf("watcher closed unexpectedly")
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Rename|fsnotify.Create|fsnotify.Remove) == 0 {
+				continue
+			}
+			// A rename or remove (the symlink swap cert-manager/kubelet use
+			// to rotate a mounted secret) means we must re-add the watch,
+			// since fsnotify watches inodes, not paths; see
+			// internal/config/watch.go's identical handling.
+			if event.Op&(fsnotify.Rename|fsnotify.Remove) != 0 {
+				_ = watcher.Add(event.Name)
+			}
+			if debounce == nil {
+				debounce = time.AfterFunc(certWatchDebounce, func() {
+					select {
+					case reload <- struct{}{}:
+					default:
+					}
+				})
+			} else {
+				debounce.Reset(certWatchDebounce)
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return fmt.Errorf("watcher closed unexpectedly")
+			}
+			return fmt.Errorf("watch error: %w", err)
+
+		case <-reload:
+			if err := s.reloadCert(); err != nil {
+				s.logger.Error("webhook: failed to reload rotated certificate, keeping previous", zap.Error(err))
+				continue
+			}
+			s.logger.Info("webhook: certificate reloaded")
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Package webhook exposes validation.ValidateServiceConstraints as a
+// Kubernetes ValidatingAdmissionWebhook, so a ServiceConstraints CRD is
+// rejected at `kubectl apply` time rather than only when the advisory
+// gRPC API is called.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pb "github.com/mysgeniels75-byte/ose-api/api/proto/advisory/v1"
+	"github.com/mysgeniels75-byte/ose-api/pkg/validation"
+)
+
+// Handler is an http.Handler implementing the ValidatingAdmissionWebhook
+// contract for ServiceConstraints custom resources.
+type Handler struct {
+	logger *zap.Logger
+}
+
+// NewHandler constructs a webhook Handler.
+func NewHandler(logger *zap.Logger) *Handler {
+	return &Handler{logger: logger}
+}
+
+// ServeHTTP decodes an AdmissionReview, validates the embedded
+// ServiceConstraints spec, and writes back an AdmissionReview carrying the
+// AdmissionResponse.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var review admissionv1.AdmissionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		http.Error(w, fmt.Sprintf("decoding AdmissionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if review.Request == nil {
+		http.Error(w, "AdmissionReview.Request is nil", http.StatusBadRequest)
+		return
+	}
+
+	response := h.review(review.Request)
+	response.UID = review.Request.UID
+
+	out := admissionv1.AdmissionReview{
+		TypeMeta: review.TypeMeta,
+		Response: response,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		h.logger.Error("failed to encode AdmissionReview response", zap.Error(err))
+	}
+}
+
+// review extracts ServiceConstraints from the raw CR spec and runs
+// validation.ValidateServiceConstraints against it.
+func (h *Handler) review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	constraints, err := decodeServiceConstraints(req.Object.Raw)
+	if err != nil {
+		h.logger.Warn("admission request had an unparseable ServiceConstraints spec",
+			zap.String("name", req.Name),
+			zap.String("namespace", req.Namespace),
+			zap.Error(err),
+		)
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Message: fmt.Sprintf("malformed ServiceConstraints: %v", err),
+			},
+		}
+	}
+
+	verr := validation.ValidateServiceConstraints(constraints)
+	if verr == nil {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	causes, annotations := toStatusCausesAndAnnotations(verr)
+
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: verr.Error(),
+			Reason:  metav1.StatusReasonInvalid,
+			Details: &metav1.StatusDetails{
+				Name:   req.Name,
+				Group:  req.Resource.Group,
+				Kind:   req.Resource.Resource,
+				Causes: causes,
+			},
+		},
+		AuditAnnotations: annotations,
+	}
+}
+
+// decodeServiceConstraints maps the CR's raw JSON/YAML spec onto the
+// advisory ServiceConstraints protobuf message. Field names follow the
+// proto's JSON mapping (lowerCamelCase), matching how the CRD schema is
+// expected to be authored. The spec sub-object is unmarshaled with
+// protojson rather than encoding/json: protoc-gen-go doesn't emit `json:`
+// tags and proto3 enums are int32-backed, so plain encoding/json can't
+// decode a spec authored with string enum values (e.g. serviceType: API).
+func decodeServiceConstraints(raw []byte) (*pb.ServiceConstraints, error) {
+	var cr struct {
+		Spec json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(raw, &cr); err != nil {
+		return nil, err
+	}
+
+	constraints := &pb.ServiceConstraints{}
+	if err := protojson.Unmarshal(cr.Spec, constraints); err != nil {
+		return nil, err
+	}
+	return constraints, nil
+}
+
+// toStatusCausesAndAnnotations converts an aggregated validation error into
+// the status.details.causes[] shape kubectl renders, plus a parallel set of
+// audit annotations (field -> suggestion) for operators inspecting
+// `kubectl get events` or the audit log.
+func toStatusCausesAndAnnotations(err error) ([]metav1.StatusCause, map[string]string) {
+	var entries []*validation.ValidationError
+
+	switch e := err.(type) {
+	case *validation.ValidationErrors:
+		entries = e.Errors
+	case *validation.ValidationError:
+		entries = []*validation.ValidationError{e}
+	default:
+		return []metav1.StatusCause{{Message: err.Error()}}, nil
+	}
+
+	causes := make([]metav1.StatusCause, 0, len(entries))
+	annotations := make(map[string]string, len(entries))
+	for _, ve := range entries {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: ve.Message,
+			Field:   ve.Field,
+		})
+		if ve.Suggestion != "" {
+			annotations[fmt.Sprintf("ose.io/suggestion.%s", ve.Field)] = ve.Suggestion
+		}
+	}
+
+	return causes, annotations
+}
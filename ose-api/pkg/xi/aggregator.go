@@ -0,0 +1,377 @@
+package xi
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultHalfLife is the EWMA half-life used when Aggregator is constructed
+// without an explicit one (OSE_XI_HALFLIFE).
+const DefaultHalfLife = 7 * 24 * time.Hour
+
+// staleAfterMultiplier times the half-life is how long a service's state may
+// go without an Observation before the background sweep evicts it.
+const staleAfterMultiplier = 2
+
+// Observation is a single event ingested by Aggregator: a pattern
+// application, a line-count report, a target-met bit, or an incident,
+// timestamped so the EWMA can weight it by elapsed time since the service's
+// last observation.
+type Observation struct {
+	Service   string
+	Timestamp time.Time
+
+	// Relevance inputs
+	PatternsApplied     int
+	PatternsRecommended int
+	AvgConfidence       float64
+
+	// Actionability inputs
+	LinesModified  int
+	LinesGenerated int
+
+	// Impact inputs
+	MetTargets   int
+	TotalTargets int
+	Incidents    []Incident
+}
+
+// Bucket is one historical time-bucketed Ξ sample returned by Range.
+type Bucket struct {
+	Start time.Time
+	Score Score
+}
+
+// serviceState is the EWMA state tracked per service.
+type serviceState struct {
+	mu sync.Mutex
+
+	relevance     float64
+	actionability float64
+	impact        float64
+	lastQuality   string
+
+	lastObserved time.Time
+	ring         []Bucket
+	ringPos      int
+}
+
+// Aggregator ingests per-service Observations and maintains exponentially
+// weighted R/A/I components per service, so production Ξ tracking reflects
+// a sliding window rather than a single registration event.
+//
+// On each observation:
+//
+//	alpha := 1 - exp(-delta_t / halfLife)
+//	component := alpha*newValue + (1-alpha)*component
+//
+// so recent observations dominate but old ones never fully vanish between
+// events — a service with no traffic simply keeps its last known score
+// until it is aged out by the background sweep.
+type Aggregator struct {
+	halfLife     time.Duration
+	bucketWidth  time.Duration
+	ringSize     int
+	staleAfter   time.Duration
+
+	shardCount int
+	shards     []*aggregatorShard
+
+	overall       *prometheus.GaugeVec
+	relevanceGV   *prometheus.GaugeVec
+	actionability *prometheus.GaugeVec
+	impactGV      *prometheus.GaugeVec
+	qualityTotal  *prometheus.CounterVec
+}
+
+type aggregatorShard struct {
+	mu       sync.RWMutex
+	services map[string]*serviceState
+}
+
+// AggregatorOption configures Aggregator construction.
+type AggregatorOption func(*Aggregator)
+
+// WithHalfLife overrides DefaultHalfLife.
+func WithHalfLife(d time.Duration) AggregatorOption {
+	return func(a *Aggregator) { a.halfLife = d }
+}
+
+// WithBucketWidth sets the width of each Range() bucket (default 1 hour).
+func WithBucketWidth(d time.Duration) AggregatorOption {
+	return func(a *Aggregator) { a.bucketWidth = d }
+}
+
+// WithShardCount sets the number of map shards used to reduce lock
+// contention across services (default 16).
+func WithShardCount(n int) AggregatorOption {
+	return func(a *Aggregator) { a.shardCount = n }
+}
+
+// NewAggregator constructs an Aggregator. Call MustRegister (or register it
+// directly, since Aggregator implements prometheus.Collector) to expose its
+// gauges/counters.
+func NewAggregator(opts ...AggregatorOption) *Aggregator {
+	a := &Aggregator{
+		halfLife:    DefaultHalfLife,
+		bucketWidth: time.Hour,
+		ringSize:    7 * 24, // one week at hourly buckets
+		shardCount:  16,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	a.staleAfter = staleAfterMultiplier * a.halfLife
+
+	a.shards = make([]*aggregatorShard, a.shardCount)
+	for i := range a.shards {
+		a.shards[i] = &aggregatorShard{services: make(map[string]*serviceState)}
+	}
+
+	a.overall = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ose", Name: "xi_overall", Help: "Current Ξ overall score per service.",
+	}, []string{"service"})
+	a.relevanceGV = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ose", Name: "xi_relevance", Help: "Current Ξ relevance component per service.",
+	}, []string{"service"})
+	a.actionability = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ose", Name: "xi_actionability", Help: "Current Ξ actionability component per service.",
+	}, []string{"service"})
+	a.impactGV = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ose", Name: "xi_impact", Help: "Current Ξ impact component per service.",
+	}, []string{"service"})
+	a.qualityTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ose", Name: "xi_quality_level_total", Help: "Count of Ξ quality-level transitions per service.",
+	}, []string{"service", "level"})
+
+	return a
+}
+
+// Describe implements prometheus.Collector.
+func (a *Aggregator) Describe(ch chan<- *prometheus.Desc) {
+	a.overall.Describe(ch)
+	a.relevanceGV.Describe(ch)
+	a.actionability.Describe(ch)
+	a.impactGV.Describe(ch)
+	a.qualityTotal.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (a *Aggregator) Collect(ch chan<- prometheus.Metric) {
+	a.overall.Collect(ch)
+	a.relevanceGV.Collect(ch)
+	a.actionability.Collect(ch)
+	a.impactGV.Collect(ch)
+	a.qualityTotal.Collect(ch)
+}
+
+// Observe ingests a single Observation, updating that service's EWMA state
+// and current-bucket ring entry.
+func (a *Aggregator) Observe(obs Observation) error {
+	relevance, err := CalculateRelevance(obs.PatternsApplied, obs.PatternsRecommended, obs.AvgConfidence)
+	if err != nil {
+		return err
+	}
+	actionability, err := CalculateActionability(obs.LinesModified, obs.LinesGenerated)
+	if err != nil {
+		return err
+	}
+	impact, err := CalculateImpact(obs.MetTargets, obs.TotalTargets, obs.Incidents)
+	if err != nil {
+		return err
+	}
+
+	a.observeComponents(obs.Service, obs.Timestamp, relevance, actionability, impact)
+	return nil
+}
+
+// ObserveScore records an already-computed Score for service at timestamp,
+// updating its EWMA state and current-bucket ring entry exactly like
+// Observe, for callers (e.g. RegisterService) that receive the R/A/I
+// components pre-aggregated rather than the raw counts Observe expects.
+func (a *Aggregator) ObserveScore(service string, timestamp time.Time, score Score) {
+	a.observeComponents(service, timestamp, score.Relevance, score.Actionability, score.Impact)
+}
+
+func (a *Aggregator) observeComponents(service string, timestamp time.Time, relevance, actionability, impact float64) {
+	shard := a.shardFor(service)
+	shard.mu.Lock()
+	state, ok := shard.services[service]
+	if !ok {
+		state = &serviceState{ring: make([]Bucket, a.ringSize)}
+		shard.services[service] = state
+	}
+	shard.mu.Unlock()
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	alpha := a.alpha(state.lastObserved, timestamp)
+	state.relevance = ewma(alpha, relevance, state.relevance)
+	state.actionability = ewma(alpha, actionability, state.actionability)
+	state.impact = ewma(alpha, impact, state.impact)
+	state.lastObserved = timestamp
+
+	score := geometricMean(state.relevance, state.actionability, state.impact)
+	level := QualityLevel(score)
+
+	bucketStart := timestamp.Truncate(a.bucketWidth)
+	// bucketStart.UnixNano()/int64(a.bucketWidth) counts whole buckets since
+	// the epoch; dividing by bucketWidth/time.Second instead truncated to 0
+	// for any sub-second bucketWidth (valid per config.Validate, which only
+	// requires XiBucket > 0), panicking the first Observe with a
+	// divide-by-zero.
+	idx := (bucketStart.UnixNano() / int64(a.bucketWidth)) % int64(len(state.ring))
+	state.ring[idx] = Bucket{
+		Start: bucketStart,
+		Score: Score{
+			Relevance:     state.relevance,
+			Actionability: state.actionability,
+			Impact:        state.impact,
+			Overall:       score,
+		},
+	}
+
+	a.overall.WithLabelValues(service).Set(score)
+	a.relevanceGV.WithLabelValues(service).Set(state.relevance)
+	a.actionability.WithLabelValues(service).Set(state.actionability)
+	a.impactGV.WithLabelValues(service).Set(state.impact)
+	if level != state.lastQuality {
+		a.qualityTotal.WithLabelValues(service, level).Inc()
+		state.lastQuality = level
+	}
+}
+
+// Snapshot returns the current Ξ Score for service, or nil if no
+// Observation has been recorded (or it has aged out).
+func (a *Aggregator) Snapshot(service string) *Score {
+	shard := a.shardFor(service)
+	shard.mu.RLock()
+	state, ok := shard.services[service]
+	shard.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return &Score{
+		Relevance:     state.relevance,
+		Actionability: state.actionability,
+		Impact:        state.impact,
+		Overall:       geometricMean(state.relevance, state.actionability, state.impact),
+	}
+}
+
+// Range returns the historical Ξ buckets for service covering
+// [now-len(ring)*bucket, now], oldest first. Buckets with no recorded
+// observation are omitted.
+func (a *Aggregator) Range(service string, now time.Time, bucket time.Duration) []Bucket {
+	shard := a.shardFor(service)
+	shard.mu.RLock()
+	state, ok := shard.services[service]
+	shard.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	cutoff := now.Add(-time.Duration(len(state.ring)) * bucket)
+	out := make([]Bucket, 0, len(state.ring))
+	for _, b := range state.ring {
+		if b.Start.IsZero() || b.Start.Before(cutoff) {
+			continue
+		}
+		out = append(out, b)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Start.Before(out[j].Start) })
+	return out
+}
+
+// RunEvictionLoop runs in the background, removing services whose last
+// Observation is older than 2x the configured half-life, until ctx is
+// cancelled. The caller is expected to run this as a goroutine.
+func (a *Aggregator) RunEvictionLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.evictStale(now)
+		}
+	}
+}
+
+func (a *Aggregator) evictStale(now time.Time) {
+	for _, shard := range a.shards {
+		shard.mu.Lock()
+		for service, state := range shard.services {
+			state.mu.Lock()
+			stale := now.Sub(state.lastObserved) > a.staleAfter
+			state.mu.Unlock()
+			if stale {
+				delete(shard.services, service)
+				a.overall.DeleteLabelValues(service)
+				a.relevanceGV.DeleteLabelValues(service)
+				a.actionability.DeleteLabelValues(service)
+				a.impactGV.DeleteLabelValues(service)
+			}
+		}
+		shard.mu.Unlock()
+	}
+}
+
+func (a *Aggregator) shardFor(service string) *aggregatorShard {
+	h := fnv32(service)
+	return a.shards[h%uint32(a.shardCount)]
+}
+
+// alpha computes the EWMA smoothing factor for the elapsed time between two
+// observations. A zero/unset lastObserved is treated as "no history", so
+// the new value fully replaces the component (alpha=1).
+func (a *Aggregator) alpha(last, next time.Time) float64 {
+	if last.IsZero() {
+		return 1.0
+	}
+	delta := next.Sub(last)
+	if delta <= 0 {
+		return 0
+	}
+	return 1 - math.Exp(-float64(delta)/float64(a.halfLife))
+}
+
+func ewma(alpha, next, prev float64) float64 {
+	return alpha*next + (1-alpha)*prev
+}
+
+func geometricMean(r, a2, i float64) float64 {
+	product := r * a2 * i
+	if product <= 0 {
+		return 0
+	}
+	return math.Pow(product, 1.0/3.0)
+}
+
+// fnv32 is a small allocation-free string hash used to shard services.
+func fnv32(s string) uint32 {
+	const (
+		offset32 = 2166136261
+		prime32  = 16777619
+	)
+	h := uint32(offset32)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= prime32
+	}
+	return h
+}
@@ -25,33 +25,13 @@ type Score struct {
 	Overall       float64 // Ξ: geometric mean of R, A, I
 }
 
-// Calculate computes the overall Ξ score from its components.
-//
-// Formula: Ξ = (R × A × I)^(1/3)
-//
-// Each component must be in the range [0.0, 1.0].
+// Calculate computes the overall Ξ score from its components using
+// DefaultCalculator (an unweighted geometric mean, Ξ = (R × A × I)^(1/3)).
+// Each component must be in the range [0.0, 1.0]. Deployments that need
+// weighted dimensions or custom thresholds should construct their own
+// Calculator (see config.go) instead of calling this package-level function.
 func Calculate(relevance, actionability, impact float64) (*Score, error) {
-	// Validate inputs
-	if err := validateComponent("Relevance", relevance); err != nil {
-		return nil, err
-	}
-	if err := validateComponent("Actionability", actionability); err != nil {
-		return nil, err
-	}
-	if err := validateComponent("Impact", impact); err != nil {
-		return nil, err
-	}
-
-	// Compute geometric mean
-	product := relevance * actionability * impact
-	overall := math.Pow(product, 1.0/3.0)
-
-	return &Score{
-		Relevance:     relevance,
-		Actionability: actionability,
-		Impact:        impact,
-		Overall:       overall,
-	}, nil
+	return DefaultCalculator.Calculate(relevance, actionability, impact)
 }
 
 // CalculateRelevance computes the Relevance (R) component.
@@ -115,68 +95,27 @@ func CalculateActionability(linesModified, linesGenerated int) (float64, error)
 	return actionability, nil
 }
 
-// CalculateImpact computes the Impact Realization (I) component.
+// CalculateImpact computes the Impact Realization (I) component using
+// DefaultCalculator's severity penalties (SEV1: 0.5, SEV2: 0.2, SEV3: 0.1).
 //
 // Formula: I = (met_targets / total_targets) × stability_factor
 //
 // Where:
 //   met_targets = number of performance targets achieved
 //   total_targets = number of performance targets specified
-//   stability_factor = 1 - (incident_count × severity_weight)
+//   stability_factor = 1 - Σ severity_weight(incident)
 //
-// Severity weights:
-//   SEV1 (Critical): 0.5
-//   SEV2 (Major):    0.2
-//   SEV3 (Minor):    0.1
+// Deployments that need different severity weights should construct their
+// own Calculator (see config.go) and call its CalculateImpact instead.
 func CalculateImpact(metTargets, totalTargets int, incidents []Incident) (float64, error) {
-	if totalTargets == 0 {
-		return 0, fmt.Errorf("total_targets must be > 0")
-	}
-
-	if metTargets < 0 || metTargets > totalTargets {
-		return 0, fmt.Errorf("invalid target counts: met=%d, total=%d",
-			metTargets, totalTargets)
-	}
-
-	// Calculate target achievement rate
-	targetScore := float64(metTargets) / float64(totalTargets)
-
-	// Calculate stability factor from incidents
-	stabilityFactor := 1.0
-	for _, incident := range incidents {
-		weight := incidentSeverityWeight(incident.Severity)
-		stabilityFactor -= weight
-	}
-
-	// Ensure stability factor doesn't go negative
-	if stabilityFactor < 0 {
-		stabilityFactor = 0
-	}
-
-	impact := targetScore * stabilityFactor
-
-	return impact, nil
+	return DefaultCalculator.CalculateImpact(metTargets, totalTargets, incidents)
 }
 
 // Incident represents a production incident that affects Impact calculation.
 type Incident struct {
-	Severity         string // "SEV1", "SEV2", "SEV3"
-	Description      string
-	DurationMinutes  int
-}
-
-// incidentSeverityWeight returns the penalty for an incident based on severity.
-func incidentSeverityWeight(severity string) float64 {
-	switch severity {
-	case "SEV1":
-		return 0.5 // Critical outage
-	case "SEV2":
-		return 0.2 // Major issue
-	case "SEV3":
-		return 0.1 // Minor issue
-	default:
-		return 0.05 // Unknown severity - minimal penalty
-	}
+	Severity        string // "SEV1", "SEV2", "SEV3"
+	Description     string
+	DurationMinutes int
 }
 
 // validateComponent ensures a Ξ component is in valid range [0.0, 1.0].
@@ -193,22 +132,11 @@ func validateComponent(name string, value float64) error {
 	return nil
 }
 
-// QualityLevel returns a human-readable quality level for a Ξ score.
+// QualityLevel returns a human-readable quality level for a Ξ score, using
+// DefaultCalculator's QualityThresholds. Deployments that need a different
+// scale should construct their own Calculator (see config.go).
 func QualityLevel(xi float64) string {
-	switch {
-	case xi >= 0.85:
-		return "EXCELLENT"
-	case xi >= 0.75:
-		return "VERY GOOD"
-	case xi >= 0.65:
-		return "GOOD"
-	case xi >= 0.50:
-		return "ACCEPTABLE"
-	case xi >= 0.35:
-		return "POOR"
-	default:
-		return "CRITICAL"
-	}
+	return DefaultCalculator.QualityLevel(xi)
 }
 
 // String returns a formatted string representation of the Score.
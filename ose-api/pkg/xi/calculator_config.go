@@ -0,0 +1,131 @@
+package xi
+
+import (
+	"fmt"
+	"math"
+)
+
+// Calculator computes Ξ scores under a Config, so operators can tune
+// dimension weights, incident penalties, and quality thresholds without
+// forking the package. DefaultCalculator wraps DefaultConfig and backs the
+// package-level Calculate/QualityLevel functions, so existing callers are
+// unaffected by a deployment that installs a custom Calculator elsewhere.
+type Calculator struct {
+	cfg Config
+}
+
+// NewCalculator constructs a Calculator from cfg. cfg.Weights is used as
+// given — callers that load weights from YAML/flags are responsible for
+// ensuring they sum to 1, since the calculator itself has no authoritative
+// way to "fix" a reweighting the operator asked for.
+func NewCalculator(cfg Config) *Calculator {
+	return &Calculator{cfg: cfg}
+}
+
+// DefaultCalculator is the Calculator backing the package-level
+// Calculate/QualityLevel functions.
+var DefaultCalculator = NewCalculator(DefaultConfig())
+
+// Calculate computes the weighted geometric mean Ξ = R^Wr × A^Wa × I^Wi
+// from its components. Each component must be in [0.0, 1.0].
+func (c *Calculator) Calculate(relevance, actionability, impact float64) (*Score, error) {
+	if err := validateComponent("Relevance", relevance); err != nil {
+		return nil, err
+	}
+	if err := validateComponent("Actionability", actionability); err != nil {
+		return nil, err
+	}
+	if err := validateComponent("Impact", impact); err != nil {
+		return nil, err
+	}
+
+	overall := weightedGeometricMean(
+		relevance, c.cfg.Weights.R,
+		actionability, c.cfg.Weights.A,
+		impact, c.cfg.Weights.I,
+	)
+
+	return &Score{
+		Relevance:     relevance,
+		Actionability: actionability,
+		Impact:        impact,
+		Overall:       overall,
+	}, nil
+}
+
+// CalculateImpact computes the Impact (I) component using c's configured
+// IncidentPenalties rather than the package's hard-coded SEV1/2/3 table.
+func (c *Calculator) CalculateImpact(metTargets, totalTargets int, incidents []Incident) (float64, error) {
+	if totalTargets == 0 {
+		return 0, fmt.Errorf("total_targets must be > 0")
+	}
+	if metTargets < 0 || metTargets > totalTargets {
+		return 0, fmt.Errorf("invalid target counts: met=%d, total=%d", metTargets, totalTargets)
+	}
+
+	targetScore := float64(metTargets) / float64(totalTargets)
+
+	stabilityFactor := 1.0
+	for _, incident := range incidents {
+		stabilityFactor -= c.cfg.severityWeight(incident.Severity)
+	}
+	if stabilityFactor < 0 {
+		stabilityFactor = 0
+	}
+
+	return targetScore * stabilityFactor, nil
+}
+
+// QualityLevel returns the label of the highest-MinScore threshold in c's
+// Config that xi clears.
+func (c *Calculator) QualityLevel(xi float64) string {
+	for _, t := range c.cfg.sortedThresholds() {
+		if xi >= t.MinScore {
+			return t.Level
+		}
+	}
+	return "CRITICAL"
+}
+
+// SensitivityReport gives, for each Ξ dimension, the partial derivative of
+// Ξ with respect to that dimension at the Score it was computed from: how
+// much Ξ would rise per unit increase in that dimension, holding the
+// others fixed. For the weighted geometric mean Ξ = ∏ xᵢ^wᵢ, this is
+// ∂Ξ/∂xᵢ = wᵢ·Ξ/xᵢ.
+type SensitivityReport struct {
+	Relevance     float64
+	Actionability float64
+	Impact        float64
+}
+
+// Sensitivity computes a SensitivityReport for score under c's Weights. A
+// dimension that is currently 0 has an undefined derivative (the weighted
+// geometric mean's slope there is infinite); Sensitivity reports 0 for it
+// rather than +Inf, since "how much would raising it from zero help" isn't
+// answerable from the point derivative alone.
+func (c *Calculator) Sensitivity(score Score) SensitivityReport {
+	return SensitivityReport{
+		Relevance:     partialDerivative(c.cfg.Weights.R, score.Overall, score.Relevance),
+		Actionability: partialDerivative(c.cfg.Weights.A, score.Overall, score.Actionability),
+		Impact:        partialDerivative(c.cfg.Weights.I, score.Overall, score.Impact),
+	}
+}
+
+func partialDerivative(weight, overall, dimension float64) float64 {
+	if dimension <= 0 {
+		return 0
+	}
+	return weight * overall / dimension
+}
+
+// weightedGeometricMean computes ∏ xᵢ^wᵢ via exp(∑ wᵢ·ln(xᵢ)), treating any
+// zero-valued dimension as making the whole product 0 (matching the
+// unweighted geometric mean's existing "one bad dimension tanks Ξ"
+// behavior).
+func weightedGeometricMean(r, wr, a, wa, i, wi float64) float64 {
+	if r == 0 || a == 0 || i == 0 {
+		return 0
+	}
+	logSum := wr*math.Log(r) + wa*math.Log(a) + wi*math.Log(i)
+	return math.Exp(logSum)
+}
@@ -367,6 +367,102 @@ func TestScoreString(t *testing.T) {
 	}
 }
 
+func TestCalculatorCalculateWithNonEqualWeights(t *testing.T) {
+	tests := []struct {
+		name          string
+		weights       Weights
+		relevance     float64
+		actionability float64
+		impact        float64
+		wantOverall   float64
+	}{
+		{
+			name:          "impact-heavy weighting",
+			weights:       Weights{R: 0.2, A: 0.2, I: 0.6},
+			relevance:     0.5,
+			actionability: 0.5,
+			impact:        1.0,
+			wantOverall:   math.Exp(0.2*math.Log(0.5) + 0.2*math.Log(0.5) + 0.6*math.Log(1.0)),
+		},
+		{
+			name:          "relevance-only weighting ignores other dimensions",
+			weights:       Weights{R: 1.0, A: 0, I: 0},
+			relevance:     0.7,
+			actionability: 0.1,
+			impact:        0.1,
+			wantOverall:   0.7,
+		},
+		{
+			name:          "any zero dimension still zeroes the product",
+			weights:       Weights{R: 0.2, A: 0.2, I: 0.6},
+			relevance:     0.5,
+			actionability: 0.0,
+			impact:        1.0,
+			wantOverall:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator(Config{Weights: tt.weights})
+			score, err := calc.Calculate(tt.relevance, tt.actionability, tt.impact)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if math.Abs(score.Overall-tt.wantOverall) > 0.001 {
+				t.Errorf("overall score = %.3f, want %.3f", score.Overall, tt.wantOverall)
+			}
+		})
+	}
+}
+
+func TestCalculatorSensitivity(t *testing.T) {
+	weights := Weights{R: 0.2, A: 0.3, I: 0.5}
+	calc := NewCalculator(Config{Weights: weights})
+
+	score := Score{Relevance: 0.8, Actionability: 0.6, Impact: 0.4}
+	score.Overall = weightedGeometricMean(
+		score.Relevance, weights.R,
+		score.Actionability, weights.A,
+		score.Impact, weights.I,
+	)
+
+	got := calc.Sensitivity(score)
+
+	want := SensitivityReport{
+		Relevance:     weights.R * score.Overall / score.Relevance,
+		Actionability: weights.A * score.Overall / score.Actionability,
+		Impact:        weights.I * score.Overall / score.Impact,
+	}
+
+	if math.Abs(got.Relevance-want.Relevance) > 0.001 {
+		t.Errorf("Relevance sensitivity = %.4f, want %.4f", got.Relevance, want.Relevance)
+	}
+	if math.Abs(got.Actionability-want.Actionability) > 0.001 {
+		t.Errorf("Actionability sensitivity = %.4f, want %.4f", got.Actionability, want.Actionability)
+	}
+	if math.Abs(got.Impact-want.Impact) > 0.001 {
+		t.Errorf("Impact sensitivity = %.4f, want %.4f", got.Impact, want.Impact)
+	}
+
+	// The highest-weighted, lowest-valued dimension (Impact) should show the
+	// steepest marginal return on improvement.
+	if got.Impact <= got.Relevance || got.Impact <= got.Actionability {
+		t.Errorf("expected Impact to have the highest sensitivity, got %+v", got)
+	}
+}
+
+func TestCalculatorSensitivityZeroDimension(t *testing.T) {
+	calc := NewCalculator(DefaultConfig())
+
+	got := calc.Sensitivity(Score{Relevance: 0, Actionability: 0.5, Impact: 0.5, Overall: 0})
+
+	if got.Relevance != 0 {
+		t.Errorf("expected 0 sensitivity for a zero-valued dimension, got %.4f", got.Relevance)
+	}
+}
+
 // Benchmark the geometric mean calculation
 func BenchmarkCalculate(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,74 @@
+package xi
+
+import "sort"
+
+// Weights are the per-dimension exponents in the weighted geometric mean
+// Ξ = R^Wr × A^Wa × I^Wi. They must sum to 1 so Ξ stays in [0, 1] whenever
+// R, A, and I do.
+type Weights struct {
+	R float64
+	A float64
+	I float64
+}
+
+// Threshold maps a minimum Ξ score to a human-readable quality level.
+// QualityLevel picks the highest-MinScore Threshold the score clears.
+type Threshold struct {
+	MinScore float64
+	Level    string
+}
+
+// Config parameterizes a Calculator: the dimension Weights used in the
+// weighted geometric mean, the per-severity stability penalties consulted
+// by CalculateImpact, and the QualityThresholds used to label a score.
+// DefaultConfig reproduces today's hard-coded behavior, so existing callers
+// of the package-level Calculate/QualityLevel see no change.
+type Config struct {
+	Weights           Weights
+	IncidentPenalties map[string]float64
+	QualityThresholds []Threshold
+}
+
+// DefaultConfig is the Config backing the package-level DefaultCalculator:
+// an unweighted (1/3, 1/3, 1/3) geometric mean, the original SEV1/2/3
+// penalty table, and the original six-tier quality scale.
+func DefaultConfig() Config {
+	return Config{
+		Weights: Weights{R: 1.0 / 3.0, A: 1.0 / 3.0, I: 1.0 / 3.0},
+		IncidentPenalties: map[string]float64{
+			"SEV1": 0.5, // Critical outage
+			"SEV2": 0.2, // Major issue
+			"SEV3": 0.1, // Minor issue
+		},
+		QualityThresholds: []Threshold{
+			{MinScore: 0.85, Level: "EXCELLENT"},
+			{MinScore: 0.75, Level: "VERY GOOD"},
+			{MinScore: 0.65, Level: "GOOD"},
+			{MinScore: 0.50, Level: "ACCEPTABLE"},
+			{MinScore: 0.35, Level: "POOR"},
+			{MinScore: 0, Level: "CRITICAL"},
+		},
+	}
+}
+
+// sortedThresholds returns cfg.QualityThresholds sorted by descending
+// MinScore, so QualityLevel can return the first one the score clears.
+func (cfg Config) sortedThresholds() []Threshold {
+	sorted := append([]Threshold(nil), cfg.QualityThresholds...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinScore > sorted[j].MinScore
+	})
+	return sorted
+}
+
+// defaultSeverityWeight is the penalty applied for an incident whose
+// severity isn't in Config.IncidentPenalties — matching the original
+// incidentSeverityWeight's "unknown severity" fallback.
+const defaultSeverityWeight = 0.05
+
+func (cfg Config) severityWeight(severity string) float64 {
+	if w, ok := cfg.IncidentPenalties[severity]; ok {
+		return w
+	}
+	return defaultSeverityWeight
+}